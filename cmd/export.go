@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bycait27/readme-generator/internal/exporter"
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export API docs as machine-readable artifacts",
+	Long:  "Export the API docs of an api-service spec or saved answers as machine-readable artifacts",
+}
+
+var exportOpenAPICmd = &cobra.Command{
+	Use:   "openapi [SPEC]",
+	Short: "Export the API docs as an OpenAPI 3.1 document",
+	Long:  "Export the API docs of an api-service spec, or of the saved answers, as an OpenAPI 3.1 YAML document",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := loadAPIService(args)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
+		data, err := exporter.OpenAPI(service)
+		if err != nil {
+			fmt.Printf("❌ Error exporting OpenAPI: %v\n", err)
+			return
+		}
+		writeExport(cmd, data, len(service.APIDocs.Endpoints))
+	},
+}
+
+var exportHTTPCmd = &cobra.Command{
+	Use:   "http [SPEC]",
+	Short: "Export the API docs as a .http request collection",
+	Long:  "Export the endpoints of an api-service spec, or of the saved answers, as a .http file for the VS Code REST Client and JetBrains HTTP Client",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := loadAPIService(args)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
+		writeExport(cmd, []byte(exporter.HTTPFile(service.APIDocs, service.Auth)), len(service.APIDocs.Endpoints))
+	},
+}
+
+// loadAPIService reads and validates the spec named in args, or the saved
+// answers
+func loadAPIService(args []string) (*models.APIService, error) {
+	path := spec.DefaultAnswersPath
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	projectType, model, err := spec.LoadAnswers(path, "")
+	if err != nil {
+		return nil, err
+	}
+	service, ok := model.(*models.APIService)
+	if !ok {
+		return nil, fmt.Errorf("%s is for the %s template, export needs api-service", path, projectType.Name)
+	}
+	if err := projectType.Validate(service); err != nil {
+		return nil, fmt.Errorf("%s is incomplete or invalid: %w", path, err)
+	}
+	if len(service.APIDocs.Endpoints) == 0 {
+		return nil, fmt.Errorf("%s has no endpoints to export", path)
+	}
+	return service, nil
+}
+
+// writeExport writes to the --output file, or stdout for "-"
+func writeExport(cmd *cobra.Command, data []byte, endpoints int) {
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		fmt.Printf("❌ Error: failed to get output flag: %v\n", err)
+		return
+	}
+
+	if output == "-" {
+		os.Stdout.Write(data)
+		return
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		fmt.Printf("❌ Error writing %s: %v\n", output, err)
+		return
+	}
+	fmt.Printf("✅ Exported %d endpoints to %s\n", endpoints, output)
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportOpenAPICmd)
+	exportCmd.AddCommand(exportHTTPCmd)
+
+	exportOpenAPICmd.Flags().StringP("output", "o", "openapi.yaml", "Output file name (- for stdout)")
+	exportHTTPCmd.Flags().StringP("output", "o", "api.http", "Output file name (- for stdout)")
+}
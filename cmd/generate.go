@@ -2,9 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/bycait27/readme-generator/internal/config"
+	"github.com/bycait27/readme-generator/internal/detect"
 	"github.com/bycait27/readme-generator/internal/generator"
-	"github.com/bycait27/readme-generator/internal/prompts"
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/registry"
+	"github.com/bycait27/readme-generator/internal/spec"
 	"github.com/spf13/cobra"
 )
 
@@ -23,41 +29,141 @@ var generateCmd = &cobra.Command{
 			fmt.Printf("❌ Error: failed to get output flag: %v\n", err)
 		}
 
+		specPath, err := cmd.Flags().GetString("spec")
+		if err != nil {
+			fmt.Printf("❌ Error: failed to get spec flag: %v\n", err)
+		}
+
+		answersPath, err := cmd.Flags().GetString("save-answers")
+		if err != nil {
+			fmt.Printf("❌ Error: failed to get save-answers flag: %v\n", err)
+		}
+
+		noDetect, err := cmd.Flags().GetBool("no-detect")
+		if err != nil {
+			fmt.Printf("❌ Error: failed to get no-detect flag: %v\n", err)
+		}
+
+		// the spec or saved answers name their own template unless -t is given
+		templateOverride := ""
+		if cmd.Flags().Changed("template") {
+			templateOverride = template
+		}
+
+		// generate straight from a spec file without prompting
+		if specPath != "" {
+			generateFromSpec(specPath, templateOverride, output)
+			return
+		}
+
+		// pre-fill prompts with the answers from the last run
+		var defaults interface{}
+		if _, err := os.Stat(answersPath); err == nil {
+			savedType, saved, err := spec.LoadAnswers(answersPath, templateOverride)
+			if err != nil {
+				fmt.Printf("⚠️  Ignoring saved answers: %v\n", err)
+			} else {
+				fmt.Printf("♻️  Pre-filling answers from %s\n", answersPath)
+				template = savedType.Name
+				defaults = saved
+			}
+		}
+
+		// look up the project type for the template
+		projectType, err := registry.Lookup(template)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
 		// validate template exists
 		if err := generator.ValidateTemplate(template); err != nil {
 			fmt.Printf("❌ Error: %v\n", err)
 			return
 		}
 
+		// fill in the author from the user's profile
+		if defaults == nil {
+			defaults = projectType.NewModel()
+		}
+		applyProfile(defaults)
+
+		// suggest whatever can be detected from the project itself
+		if !noDetect {
+			facts, err := detect.Run(".")
+			if err != nil {
+				fmt.Printf("⚠️  %v\n", err)
+			}
+			detect.Apply(facts, defaults)
+		}
+
 		// get project information through prompts
 		fmt.Println("🚀 Let's create your README!")
-		baseInfo, err := prompts.PromptBaseInfo()
+		info, err := projectType.Prompt(defaults)
 		if err != nil {
 			fmt.Printf("❌ Error collecting project info: %v\n", err)
 			return
 		}
 
 		// generate README
-		var genErr error
-		if output == "README.md" {
-			genErr = generator.GenerateREADME(template, baseInfo)
-		} else {
-			genErr = generator.GenerateREADMEToFile(template, baseInfo, output)
-		}
-
-		if genErr != nil {
-			fmt.Printf("❌ Error generating README: %v\n", genErr)
+		if err := projectType.Render(info, output); err != nil {
+			fmt.Printf("❌ Error generating README: %v\n", err)
 			return
 		}
 
 		fmt.Printf("✅ README generated successfully: %s\n", output)
+
+		// keep the answers so the next run can start from them
+		if err := spec.Save(answersPath, projectType.Name, info); err != nil {
+			fmt.Printf("⚠️  Could not save answers: %v\n", err)
+		} else {
+			fmt.Printf("💾 Answers saved to %s (reused as defaults next time)\n", answersPath)
+		}
+
 		fmt.Println("📝 Don't forget to customize the installation and usage sections!")
 	},
 }
 
+// applyProfile fills the author of defaults from the user's profile unless
+// the saved answers already have one
+func applyProfile(defaults interface{}) {
+	project, ok := defaults.(models.Project)
+	if !ok || project.Base().Author != (models.AuthorInfo{}) {
+		return
+	}
+
+	profile, err := config.LoadProfile()
+	if err != nil {
+		fmt.Printf("⚠️  Ignoring author profile: %v\n", err)
+		return
+	}
+	if profile != nil {
+		project.Base().Author = profile.Author
+	}
+}
+
+// generateFromSpec renders a README from a YAML, JSON or TOML spec file
+func generateFromSpec(specPath, template, output string) {
+	projectType, info, err := spec.Load(specPath, template)
+	if err != nil {
+		fmt.Printf("❌ Error loading spec:\n%v\n", err)
+		os.Exit(1)
+	}
+
+	if err := projectType.Render(info, output); err != nil {
+		fmt.Printf("❌ Error generating README: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ README generated successfully from %s: %s\n", specPath, output)
+}
+
 func init() {
 	rootCmd.AddCommand(generateCmd)
 
-	generateCmd.Flags().StringP("template", "t", "basic", "Template to use (basic)")
+	generateCmd.Flags().StringP("template", "t", "basic", fmt.Sprintf("Template to use (%s)", strings.Join(registry.Names(), ", ")))
 	generateCmd.Flags().StringP("output", "o", "README.md", "Output file name")
+	generateCmd.Flags().StringP("spec", "s", "", "Generate without prompts from a YAML, JSON or TOML spec file")
+	generateCmd.Flags().String("save-answers", spec.DefaultAnswersPath, "YAML file the answers are saved to and pre-filled from")
+	generateCmd.Flags().Bool("no-detect", false, "Don't pre-fill answers detected from the project (git, manifests)")
 }
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/importer"
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/registry"
+	"github.com/bycait27/readme-generator/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import project details into the saved answers",
+	Long:  "Import project details from existing sources into the saved answers, so the next generate run offers them as defaults",
+}
+
+var importHelpCmd = &cobra.Command{
+	Use:   "help -- BINARY [ARGS...]",
+	Short: "Import commands and flags from a CLI's --help output",
+	Long: `Run a locally built CLI with --help, follow the subcommands it lists and
+save them as the commands and flags of a cli-tool README. Cobra, urfave/cli,
+argparse and clap help layouts are recognised.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		help, err := importer.ImportHelp(args[0], args[1:]...)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+		if len(help.Commands) == 0 {
+			fmt.Println("⚠️  No commands found in the help output")
+			return
+		}
+
+		err = updateAnswers(cmd, "cli-tool", func(model interface{}) {
+			tool := model.(*models.CLITool)
+			tool.Commands = help.Commands
+			if tool.Usage.BasicUsage == "" {
+				tool.Usage.BasicUsage = help.BasicUsage
+			}
+		})
+		if err != nil {
+			fmt.Printf("❌ Error saving answers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("📥 Imported %d commands from %s\n", len(help.Commands), args[0])
+	},
+}
+
+var importOpenAPICmd = &cobra.Command{
+	Use:   "openapi FILE",
+	Short: "Import API docs from an OpenAPI 3 or Swagger 2 document",
+	Long: `Read an OpenAPI 3 or Swagger 2 document (YAML or JSON) and save its base URL,
+endpoints, parameters, responses and security schemes as the API docs of an
+api-service README.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		imported, err := importer.ImportOpenAPI(args[0])
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
+		err = updateAnswers(cmd, "api-service", func(model interface{}) {
+			service := model.(*models.APIService)
+			grpc := service.APIDocs.GRPC
+			service.APIDocs = imported.APIDocs
+			service.APIDocs.GRPC = grpc
+			if imported.Auth != nil {
+				service.Auth = imported.Auth
+			}
+			if service.Title == "" {
+				service.Title = imported.Title
+			}
+			if service.Description == "" {
+				service.Description = imported.Description
+			}
+		})
+		if err != nil {
+			fmt.Printf("❌ Error saving answers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("📥 Imported %d endpoints from %s\n", len(imported.APIDocs.Endpoints), args[0])
+	},
+}
+
+var importGraphQLCmd = &cobra.Command{
+	Use:   "graphql FILE...",
+	Short: "Import GraphQL API docs from an SDL schema",
+	Long: `Read a GraphQL schema written in SDL, possibly split over several files, and
+save its queries, mutations, subscriptions and the types they use as the
+GraphQL docs of an api-service README.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		imported, err := importer.ImportGraphQL(args...)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
+		endpoint, err := cmd.Flags().GetString("endpoint")
+		if err != nil {
+			fmt.Printf("❌ Error: failed to get endpoint flag: %v\n", err)
+			return
+		}
+
+		err = updateAnswers(cmd, "api-service", func(model interface{}) {
+			service := model.(*models.APIService)
+			// keep the endpoint and playground from earlier answers
+			if service.GraphQL != nil {
+				imported.Endpoint, imported.Playground = service.GraphQL.Endpoint, service.GraphQL.Playground
+			}
+			if endpoint != "" {
+				imported.Endpoint = endpoint
+			}
+			service.GraphQL = imported
+		})
+		if err != nil {
+			fmt.Printf("❌ Error saving answers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("📥 Imported %d queries, %d mutations and %d subscriptions from %s\n",
+			len(imported.Queries), len(imported.Mutations), len(imported.Subscriptions), strings.Join(args, ", "))
+	},
+}
+
+var importProtoCmd = &cobra.Command{
+	Use:   "proto FILE...",
+	Short: "Import gRPC API docs from .proto files",
+	Long: `Read protobuf definitions, possibly split over several .proto files, and save
+their services, methods and the messages those methods use as the gRPC docs of
+an api-service README. Comments above each declaration become descriptions.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		imported, err := importer.ImportProto(args...)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
+		address, err := cmd.Flags().GetString("address")
+		if err != nil {
+			fmt.Printf("❌ Error: failed to get address flag: %v\n", err)
+			return
+		}
+
+		methods := 0
+		for _, service := range imported.Services {
+			methods += len(service.Methods)
+		}
+
+		err = updateAnswers(cmd, "api-service", func(model interface{}) {
+			service := model.(*models.APIService)
+			// the address is kept apart from the base URL the REST
+			// endpoints are called at
+			if address == "" && service.APIDocs.GRPC != nil {
+				address = service.APIDocs.GRPC.Address
+			}
+			imported.Address = address
+			service.APIDocs.GRPC = imported
+			if service.APIDocs.BaseURL == "" {
+				service.APIDocs.BaseURL = address
+			}
+		})
+		if err != nil {
+			fmt.Printf("❌ Error saving answers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("📥 Imported %d services with %d methods from %s\n", len(imported.Services), methods, strings.Join(args, ", "))
+	},
+}
+
+// updateAnswers applies an import to the saved answers for templateName,
+// starting from an empty model when nothing has been saved yet
+func updateAnswers(cmd *cobra.Command, templateName string, update func(model interface{})) error {
+	answersPath, err := cmd.Flags().GetString("answers")
+	if err != nil {
+		return err
+	}
+
+	projectType, err := registry.Lookup(templateName)
+	if err != nil {
+		return err
+	}
+	model := projectType.NewModel()
+	if _, err := os.Stat(answersPath); err == nil {
+		if _, model, err = spec.LoadAnswers(answersPath, templateName); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	update(model)
+	if err := spec.Save(answersPath, templateName, model); err != nil {
+		return err
+	}
+
+	fmt.Printf("💾 Answers saved to %s (run `readme-gen generate` to review them)\n", answersPath)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importHelpCmd)
+	importCmd.AddCommand(importOpenAPICmd)
+	importCmd.AddCommand(importGraphQLCmd)
+	importCmd.AddCommand(importProtoCmd)
+
+	importCmd.PersistentFlags().String("answers", spec.DefaultAnswersPath, "YAML answers file the import is merged into")
+	importGraphQLCmd.Flags().String("endpoint", "", "URL of the GraphQL endpoint, e.g. https://api.example.com/graphql")
+	importProtoCmd.Flags().String("address", "", "address of the gRPC server, e.g. grpc://localhost:50051")
+}
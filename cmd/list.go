@@ -2,29 +2,66 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/bycait27/readme-generator/internal/generator"
+	"github.com/bycait27/readme-generator/internal/registry"
 	"github.com/spf13/cobra"
 )
 
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List available templates",
-	Long:  "List all available README templates",
+	Long:  "List all available README templates, or with --funcs the functions templates can call",
 	Run: func(cmd *cobra.Command, args []string) {
-		templates, err := generator.ListAvailableTemplates()
+		funcs, err := cmd.Flags().GetBool("funcs")
 		if err != nil {
-			fmt.Printf("❌ Error listing templates: %v\n", err)
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+		if funcs {
+			fmt.Println("🧰 Template functions:")
+			for _, f := range generator.Funcs() {
+				fmt.Printf("  • %s - %s\n      %s\n", f.Name, f.Doc, f.Usage)
+			}
 			return
 		}
 
 		fmt.Println("📋 Available templates:")
-		for _, template := range templates {
-			fmt.Printf("  • %s\n", template)
+		for _, projectType := range registry.All() {
+			source, err := generator.TemplateSource(projectType.Name)
+			if err != nil {
+				fmt.Printf("  • %s - ⚠️  template file missing\n", projectType.Name)
+				continue
+			}
+			if source != "built-in" {
+				fmt.Printf("  • %s - %s (from %s)\n", projectType.Name, projectType.Description, source)
+				continue
+			}
+			fmt.Printf("  • %s - %s\n", projectType.Name, projectType.Description)
+		}
+
+		// custom templates extending a built-in one
+		available, err := generator.ListAvailableTemplates()
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+		for _, name := range available {
+			if slices.Contains(registry.Names(), name) {
+				continue
+			}
+			projectType, err := registry.Lookup(name)
+			if err != nil {
+				continue
+			}
+			source, _ := generator.TemplateSource(name)
+			fmt.Printf("  • %s - %s (from %s)\n", projectType.Name, projectType.Description, source)
 		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(listCmd)
+	listCmd.Flags().Bool("funcs", false, "List the functions templates can call")
 }
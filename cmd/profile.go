@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/bycait27/readme-generator/internal/config"
+	"github.com/bycait27/readme-generator/internal/prompts"
+	"github.com/spf13/cobra"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage your author profile",
+	Long:  "Manage the author profile used to fill in the Contact section of every README",
+}
+
+var profileSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Save your author profile",
+	Long:  "Save your author profile. Without flags you are prompted for each field.",
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, err := config.LoadProfile()
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+		if profile == nil {
+			profile = &config.Profile{}
+		}
+
+		// flags update single fields, otherwise prompt for all of them
+		flagsGiven := false
+		for flag, field := range map[string]*string{
+			"name":    &profile.Author.Name,
+			"email":   &profile.Author.Email,
+			"github":  &profile.Author.GitHub,
+			"website": &profile.Author.Website,
+		} {
+			if cmd.Flags().Changed(flag) {
+				*field, _ = cmd.Flags().GetString(flag)
+				flagsGiven = true
+			}
+		}
+
+		if !flagsGiven {
+			author, err := prompts.PromptAuthorInfo(&profile.Author)
+			if err != nil {
+				fmt.Printf("❌ Error collecting author info: %v\n", err)
+				return
+			}
+			profile.Author = *author
+		}
+
+		if err := config.SaveProfile(profile); err != nil {
+			fmt.Printf("❌ Error saving profile: %v\n", err)
+			return
+		}
+
+		path, _ := config.ProfilePath()
+		fmt.Printf("✅ Profile saved: %s\n", path)
+	},
+}
+
+var profileShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show your author profile",
+	Long:  "Show the author profile used to fill in the Contact section",
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, err := config.LoadProfile()
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+		if profile == nil {
+			fmt.Println("No profile saved yet. Run `readme-gen profile set` to create one.")
+			return
+		}
+
+		path, _ := config.ProfilePath()
+		fmt.Printf("👤 Profile (%s):\n", path)
+		fmt.Printf("  Name:    %s\n", profile.Author.Name)
+		fmt.Printf("  Email:   %s\n", profile.Author.Email)
+		fmt.Printf("  GitHub:  %s\n", profile.Author.GitHub)
+		if profile.Author.Website != "" {
+			fmt.Printf("  Website: %s\n", profile.Author.Website)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileSetCmd)
+	profileCmd.AddCommand(profileShowCmd)
+
+	profileSetCmd.Flags().String("name", "", "Author name")
+	profileSetCmd.Flags().String("email", "", "Author email")
+	profileSetCmd.Flags().String("github", "", "Author GitHub profile URL")
+	profileSetCmd.Flags().String("website", "", "Author website URL")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/bycait27/readme-generator/internal/generator"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +14,10 @@ var rootCmd = &cobra.Command{
 	Long: `readme-gen is a CLI professional README generator that helps 
 you create professional looking README files for your projects 
 fast.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		dir, _ := cmd.Flags().GetString("templates-dir")
+		generator.SetTemplatesDir(dir)
+	},
 }
 
 func Execute() {
@@ -21,3 +26,7 @@ func Execute() {
 		os.Exit(1)
 	}
 }
+
+func init() {
+	rootCmd.PersistentFlags().String("templates-dir", "", "Directory searched first for templates, overriding built-ins by name (also $"+generator.TemplatesEnv+")")
+}
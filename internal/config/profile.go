@@ -0,0 +1,85 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// Profile holds user-level settings shared by every project
+type Profile struct {
+	Author models.AuthorInfo `yaml:"author"`
+}
+
+// Dir returns the user config directory for readme-gen
+// ($XDG_CONFIG_HOME/readme-gen on Linux)
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find user config directory: %w", err)
+	}
+	return filepath.Join(dir, "readme-gen"), nil
+}
+
+// ProfilePath returns where the user's profile is stored
+func ProfilePath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "profile.yaml"), nil
+}
+
+// LoadProfile reads the user's profile, returning nil if none has been saved
+func LoadProfile() (*Profile, error) {
+	path, err := ProfilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile %s: %w", path, err)
+	}
+
+	var profile Profile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse profile %s: %w", path, err)
+	}
+
+	return &profile, nil
+}
+
+// SaveProfile validates and writes the user's profile
+func SaveProfile(profile *Profile) error {
+	if err := validation.ValidateStruct(profile); err != nil {
+		return err
+	}
+
+	path, err := ProfilePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("failed to encode profile: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write profile %s: %w", path, err)
+	}
+
+	return nil
+}
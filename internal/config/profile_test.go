@@ -0,0 +1,58 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestProfile_SaveAndLoad(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	// no profile yet
+	profile, err := LoadProfile()
+	if err != nil {
+		t.Fatalf("LoadProfile failed: %v", err)
+	}
+	if profile != nil {
+		t.Errorf("expected no profile, got %+v", profile)
+	}
+
+	saved := &Profile{
+		Author: models.AuthorInfo{
+			Name:   "Test Author",
+			Email:  "test@example.com",
+			GitHub: "https://github.com/testuser",
+		},
+	}
+	if err := SaveProfile(saved); err != nil {
+		t.Fatalf("SaveProfile failed: %v", err)
+	}
+
+	path, err := ProfilePath()
+	if err != nil {
+		t.Fatalf("ProfilePath failed: %v", err)
+	}
+	if path != filepath.Join(configHome, "readme-gen", "profile.yaml") {
+		t.Errorf("profile should live under XDG_CONFIG_HOME, got %s", path)
+	}
+
+	profile, err = LoadProfile()
+	if err != nil {
+		t.Fatalf("LoadProfile failed: %v", err)
+	}
+	if profile == nil || profile.Author != saved.Author {
+		t.Errorf("expected %+v, got %+v", saved, profile)
+	}
+}
+
+func TestSaveProfile_Invalid(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	err := SaveProfile(&Profile{Author: models.AuthorInfo{Name: "Test Author", Email: "bad-email"}})
+	if err == nil {
+		t.Error("invalid profile should not be saved")
+	}
+}
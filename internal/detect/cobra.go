@@ -0,0 +1,17 @@
+package detect
+
+import "github.com/bycait27/readme-generator/internal/importer"
+
+func init() {
+	Register(Detector{Name: "cobra", Detect: detectCobra})
+}
+
+// detectCobra proposes the commands and flags of a cobra CLI
+func detectCobra(dir string, facts *Facts) error {
+	commands, err := importer.CobraCommands(dir)
+	if err != nil {
+		return err
+	}
+	appendIfEmpty(&facts.Commands, commands)
+	return nil
+}
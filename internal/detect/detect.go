@@ -0,0 +1,201 @@
+package detect
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// Facts holds what was discovered about a project. Every value is only a
+// suggestion and is used as a prompt default, never as a final answer.
+type Facts struct {
+	Title           string
+	Description     string
+	Author          models.AuthorInfo
+	RepoURL         string // web url of the origin repository
+	BuildCmd        string
+	TechStack       models.TechStack
+	Prerequisites   []string
+	PackageManagers []models.PackageManager
+	RunCommands     []string
+	TestCommand     string
+	TestFramework   string
+	EnvVars         []models.EnvVar
+	Commands        []models.Command
+	Endpoints       []models.Endpoint
+	GraphQL         *models.GraphQLDocs
+
+	// frameworks named as in FrontendStructure and BackendStructure
+	FrontendFramework string
+	BackendFramework  string
+}
+
+// Detector inspects a project directory and records what it finds in facts
+type Detector struct {
+	Name   string
+	Detect func(dir string, facts *Facts) error
+}
+
+var detectors []Detector
+
+// framework pairs a dependency name with the framework it implies
+type framework struct {
+	dependency string
+	name       string
+}
+
+// Register adds a detector. Detectors run in registration order and later
+// ones should only fill in facts that are still empty.
+func Register(d Detector) {
+	if d.Name == "" || d.Detect == nil {
+		panic("detect: detector needs a name and a detect function")
+	}
+	detectors = append(detectors, d)
+}
+
+// Run runs every detector against dir. A failing detector doesn't stop the
+// others; its error is returned alongside whatever the rest found.
+func Run(dir string) (*Facts, error) {
+	facts := &Facts{}
+	var errs []error
+	for _, d := range detectors {
+		if err := d.Detect(dir, facts); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", d.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return facts, fmt.Errorf("detection incomplete: %v", errs)
+	}
+	return facts, nil
+}
+
+// Apply copies facts into the empty fields of a project model so they show
+// up as pre-filled defaults. Values the user already gave are kept.
+func Apply(facts *Facts, model interface{}) {
+	if project, ok := model.(models.Project); ok {
+		base := project.Base()
+		setIfEmpty(&base.Title, facts.Title)
+		setIfEmpty(&base.Description, facts.Description)
+		setIfEmpty(&base.Author.Name, facts.Author.Name)
+		setIfEmpty(&base.Author.Email, facts.Author.Email)
+		setIfEmpty(&base.Author.GitHub, facts.Author.GitHub)
+
+		if facts.TechStack.Language != "" {
+			if base.TechStack == nil {
+				base.TechStack = &models.TechStack{}
+			}
+			setIfEmpty(&base.TechStack.Language, facts.TechStack.Language)
+			setIfEmpty(&base.TechStack.Framework, facts.TechStack.Framework)
+			setIfEmpty(&base.TechStack.Database, facts.TechStack.Database)
+			appendIfEmpty(&base.TechStack.Dependencies, facts.TechStack.Dependencies)
+		}
+	}
+
+	switch m := model.(type) {
+	case *models.CLITool:
+		appendIfEmpty(&m.Commands, facts.Commands)
+		appendIfEmpty(&m.Installation.PackageManagers, facts.PackageManagers)
+		if len(facts.EnvVars) > 0 {
+			if m.Configuration == nil {
+				m.Configuration = &models.Configuration{}
+			}
+			appendIfEmpty(&m.Configuration.EnvVars, facts.EnvVars)
+		}
+		if facts.RepoURL != "" {
+			if m.Installation.FromSource == nil {
+				m.Installation.FromSource = &models.SourceInstall{}
+			}
+			setIfEmpty(&m.Installation.FromSource.RepoURL, facts.RepoURL)
+			setIfEmpty(&m.Installation.FromSource.BuildCmd, facts.BuildCmd)
+			appendIfEmpty(&m.Installation.FromSource.Requirements, facts.Prerequisites)
+		}
+	case *models.APIService:
+		applyGettingStarted(facts, &m.GettingStarted)
+		appendIfEmpty(&m.APIDocs.Endpoints, facts.Endpoints)
+		if m.GraphQL == nil {
+			m.GraphQL = facts.GraphQL
+		}
+		appendIfEmpty(&m.EnvVars, facts.EnvVars)
+		m.Testing = suggestTesting(facts, m.Testing)
+	case *models.FullStackApp:
+		applyGettingStarted(facts, &m.GettingStarted)
+		appendIfEmpty(&m.EnvVars, facts.EnvVars)
+		m.Testing = suggestTesting(facts, m.Testing)
+
+		if facts.FrontendFramework != "" || facts.BackendFramework != "" {
+			if m.AppStructure == nil {
+				m.AppStructure = &models.AppStructure{}
+			}
+			if facts.FrontendFramework != "" {
+				if m.AppStructure.Frontend == nil {
+					m.AppStructure.Frontend = &models.FrontendStructure{}
+				}
+				setIfEmpty(&m.AppStructure.Frontend.Framework, facts.FrontendFramework)
+			}
+			if facts.BackendFramework != "" {
+				if m.AppStructure.Backend == nil {
+					m.AppStructure.Backend = &models.BackendStructure{}
+				}
+				setIfEmpty(&m.AppStructure.Backend.Framework, facts.BackendFramework)
+			}
+		}
+	}
+}
+
+func applyGettingStarted(facts *Facts, gettingStarted *models.GettingStarted) {
+	appendIfEmpty(&gettingStarted.Prerequisites, facts.Prerequisites)
+	appendIfEmpty(&gettingStarted.RunCommands, facts.RunCommands)
+}
+
+// suggestTesting fills in the detected test command, creating the testing
+// section when a test command was found
+func suggestTesting(facts *Facts, testing *models.Testing) *models.Testing {
+	if facts.TestCommand == "" {
+		return testing
+	}
+	if testing == nil {
+		testing = &models.Testing{}
+	}
+	setIfEmpty(&testing.TestCommand, facts.TestCommand)
+	setIfEmpty(&testing.TestFramework, facts.TestFramework)
+	return testing
+}
+
+func setIfEmpty(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+func appendIfEmpty[T any](field *[]T, values []T) {
+	if len(*field) == 0 && len(values) > 0 {
+		*field = append([]T(nil), values...)
+	}
+}
+
+// matchFramework returns the first framework whose dependency is present
+func matchFramework(frameworks []framework, hasDependency func(string) bool) string {
+	for _, f := range frameworks {
+		if hasDependency(f.dependency) {
+			return f.name
+		}
+	}
+	return ""
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// hasName reports membership case-insensitively, as Python and Rust
+// package names are
+func hasName(names []string) func(string) bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[strings.ToLower(name)] = true
+	}
+	return func(name string) bool { return set[strings.ToLower(name)] }
+}
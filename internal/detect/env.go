@@ -0,0 +1,18 @@
+package detect
+
+import "github.com/bycait27/readme-generator/internal/importer"
+
+func init() {
+	Register(Detector{Name: "env", Detect: detectEnv})
+}
+
+// detectEnv proposes environment variables from example env files and
+// the Go source
+func detectEnv(dir string, facts *Facts) error {
+	envVars, err := importer.EnvVars(dir)
+	if err != nil {
+		return err
+	}
+	appendIfEmpty(&facts.EnvVars, envVars)
+	return nil
+}
@@ -0,0 +1,207 @@
+package detect
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func init() {
+	Register(Detector{Name: "git", Detect: detectGit})
+}
+
+// detectGit reads the author from git config and the repository from the
+// origin remote. It reads the files directly so no git binary is needed.
+func detectGit(dir string, facts *Facts) error {
+	gitDir, workTree, err := findGitDir(dir)
+	if err != nil {
+		return err
+	}
+	if gitDir == "" {
+		return nil // not a git repository
+	}
+
+	// global config first so the repository config can override it
+	config := map[string]string{}
+	for _, path := range globalGitConfigPaths() {
+		if err := readGitConfig(path, config); err != nil {
+			return err
+		}
+	}
+	commonDir, err := gitCommonDir(gitDir)
+	if err != nil {
+		return err
+	}
+	if err := readGitConfig(filepath.Join(commonDir, "config"), config); err != nil {
+		return err
+	}
+	if commonDir != gitDir {
+		// a linked worktree may have settings of its own
+		if err := readGitConfig(filepath.Join(gitDir, "config.worktree"), config); err != nil {
+			return err
+		}
+	}
+
+	setIfEmpty(&facts.Author.Name, config["user.name"])
+	setIfEmpty(&facts.Author.Email, config["user.email"])
+
+	if host, owner, repo, ok := parseRemoteURL(config[`remote "origin".url`]); ok {
+		setIfEmpty(&facts.RepoURL, fmt.Sprintf("https://%s/%s/%s", host, owner, repo))
+		if host == "github.com" {
+			setIfEmpty(&facts.Author.GitHub, "https://github.com/"+owner)
+		}
+		setIfEmpty(&facts.Title, repo)
+	}
+	setIfEmpty(&facts.Title, filepath.Base(workTree))
+
+	return nil
+}
+
+// findGitDir walks up from dir to the repository root. It returns the git
+// directory, following the "gitdir:" file used by worktrees and submodules,
+// and the work tree it belongs to. For a linked worktree that's
+// .git/worktrees/<name>; see gitCommonDir for the shared config.
+func findGitDir(dir string) (gitDir, workTree string, err error) {
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	for {
+		path := filepath.Join(dir, ".git")
+		info, err := os.Stat(path)
+		switch {
+		case err == nil && info.IsDir():
+			return path, dir, nil
+		case err == nil:
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return "", "", err
+			}
+			target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+			if !ok {
+				return "", "", fmt.Errorf("%s: not a gitdir file", path)
+			}
+			target = strings.TrimSpace(target)
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(dir, target)
+			}
+			return target, dir, nil
+		case !errors.Is(err, os.ErrNotExist):
+			return "", "", err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+// gitCommonDir returns the directory holding the config shared by the
+// worktrees of a repository. A linked worktree's git directory names it in
+// its commondir file; any other git directory is its own.
+func gitCommonDir(gitDir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(gitDir, "commondir"))
+	if errors.Is(err, os.ErrNotExist) {
+		return gitDir, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	commonDir := strings.TrimSpace(string(data))
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(gitDir, commonDir)
+	}
+	return commonDir, nil
+}
+
+// globalGitConfigPaths lists the user level git config files in the order
+// git reads them
+func globalGitConfigPaths() []string {
+	var paths []string
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		paths = append(paths, filepath.Join(xdg, "git", "config"))
+	} else if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "git", "config"))
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".gitconfig"))
+	}
+	return paths
+}
+
+// readGitConfig adds the keys of a git config file to config, named like
+// `section.key` or `section "subsection".key`. Missing files are skipped.
+func readGitConfig(path string, config map[string]string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name, sub, hasSub := strings.Cut(strings.Trim(line, "[]"), " ")
+			section = strings.ToLower(name)
+			if hasSub {
+				section += " " + strings.TrimSpace(sub)
+			}
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		config[section+"."+key] = value
+	}
+	return scanner.Err()
+}
+
+// parseRemoteURL splits https, ssh and scp-like remote urls into their
+// host, owner and repository name
+func parseRemoteURL(remote string) (host, owner, repo string, ok bool) {
+	remote = strings.TrimSpace(remote)
+	if remote == "" {
+		return "", "", "", false
+	}
+
+	var path string
+	if scheme, rest, found := strings.Cut(remote, "://"); found {
+		if scheme == "file" {
+			return "", "", "", false
+		}
+		host, path, _ = strings.Cut(rest, "/")
+	} else if h, p, found := strings.Cut(remote, ":"); found {
+		host, path = h, p // git@github.com:owner/repo.git
+	} else {
+		return "", "", "", false
+	}
+
+	// drop credentials and ports
+	if _, h, found := strings.Cut(host, "@"); found {
+		host = h
+	}
+	host, _, _ = strings.Cut(host, ":")
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	i := strings.LastIndex(path, "/")
+	if host == "" || i <= 0 || i == len(path)-1 {
+		return "", "", "", false
+	}
+	return strings.ToLower(host), path[:i], path[i+1:], true
+}
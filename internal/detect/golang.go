@@ -0,0 +1,180 @@
+package detect
+
+import (
+	"errors"
+	"go/doc/comment"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"golang.org/x/mod/modfile"
+)
+
+func init() {
+	Register(Detector{Name: "go", Detect: detectGo})
+}
+
+// goFrameworks are the backend frameworks by module path prefix, in the
+// order they're preferred when a module requires several
+var goFrameworks = []framework{
+	{"github.com/gin-gonic/gin", "Gin"},
+	{"github.com/labstack/echo", "Echo"},
+	{"github.com/gofiber/fiber", "Fiber"},
+}
+
+// maxDescription matches the limit on BaseInfo.Description
+const maxDescription = 500
+
+// detectGo suggests the tech stack, install command and description of a
+// Go module from its go.mod and main package
+func detectGo(dir string, facts *Facts) error {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil // not a Go module
+	}
+	if err != nil {
+		return err
+	}
+
+	mod, err := modfile.ParseLax("go.mod", data, nil)
+	if err != nil {
+		return err
+	}
+
+	// tech stack
+	setIfEmpty(&facts.TechStack.Language, "Go")
+	var dependencies []string
+	for _, req := range mod.Require {
+		if req.Indirect {
+			continue
+		}
+		dependencies = append(dependencies, req.Mod.Path)
+	}
+	framework := matchFramework(goFrameworks, func(prefix string) bool {
+		return slices.ContainsFunc(dependencies, func(path string) bool { return strings.HasPrefix(path, prefix) })
+	})
+	setIfEmpty(&facts.TechStack.Framework, framework)
+	setIfEmpty(&facts.BackendFramework, framework)
+	appendIfEmpty(&facts.TechStack.Dependencies, dependencies)
+	setIfEmpty(&facts.TestCommand, "go test ./...")
+
+	// minimum Go version
+	if mod.Go != nil {
+		appendIfEmpty(&facts.Prerequisites, []string{"Go " + mod.Go.Version + " or later"})
+	}
+
+	// installation and description come from the main package
+	mainDir, doc, err := findMainPackage(dir)
+	if err != nil {
+		return err
+	}
+	if mainDir == "" || mod.Module == nil {
+		return nil
+	}
+
+	importPath := path.Join(mod.Module.Mod.Path, filepath.ToSlash(mainDir))
+	appendIfEmpty(&facts.PackageManagers, []models.PackageManager{
+		{Name: "go", Command: "go install " + importPath + "@latest"},
+	})
+	if mainDir == "." {
+		setIfEmpty(&facts.BuildCmd, "go build")
+	} else {
+		setIfEmpty(&facts.BuildCmd, "go build ./"+filepath.ToSlash(mainDir))
+	}
+	setIfEmpty(&facts.Description, docSynopsis(doc))
+
+	return nil
+}
+
+// findMainPackage looks for package main in the module root and then in the
+// directories under cmd/, returning its path relative to dir and its package
+// doc comment
+func findMainPackage(dir string) (string, string, error) {
+	candidates := []string{"."}
+	entries, err := os.ReadDir(filepath.Join(dir, "cmd"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", "", err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			candidates = append(candidates, filepath.Join("cmd", entry.Name()))
+		}
+	}
+
+	for _, candidate := range candidates {
+		doc, isMain, err := packageDoc(filepath.Join(dir, candidate))
+		if err != nil {
+			return "", "", err
+		}
+		if isMain {
+			return candidate, doc, nil
+		}
+	}
+	return "", "", nil
+}
+
+// packageDoc reads the package clauses of the Go files in dir, reporting
+// whether it is package main and the first package doc comment found
+func packageDoc(dir string) (string, bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", false, err
+	}
+
+	var doc string
+	isMain := false
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.PackageClauseOnly|parser.ParseComments)
+		if err != nil {
+			continue // unparsable files don't stop detection
+		}
+		if f.Name.Name != "main" {
+			continue
+		}
+		isMain = true
+		if doc == "" && f.Doc != nil {
+			doc = f.Doc.Text()
+		}
+	}
+	return doc, isMain, nil
+}
+
+// docSynopsis turns a package doc comment into plain text, keeping whole
+// paragraphs up to the description limit
+func docSynopsis(doc string) string {
+	if doc == "" {
+		return ""
+	}
+
+	var p comment.Parser
+	var pr comment.Printer
+	text := strings.TrimSpace(string(pr.Text(p.Parse(doc))))
+
+	var synopsis string
+	for i, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.Join(strings.Fields(paragraph), " ")
+		if i == 0 && len(paragraph) > maxDescription {
+			// a long first paragraph is cut at a word boundary
+			cut := strings.LastIndex(paragraph[:maxDescription], " ")
+			if cut <= 0 {
+				cut = maxDescription
+			}
+			return paragraph[:cut]
+		}
+		next := strings.TrimSpace(synopsis + " " + paragraph)
+		if len(next) > maxDescription {
+			break
+		}
+		synopsis = next
+	}
+	return synopsis
+}
@@ -0,0 +1,96 @@
+package detect
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDetectGo(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), `module github.com/janedoe/my-tool
+
+go 1.22
+
+require (
+	github.com/spf13/cobra v1.9.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require github.com/spf13/pflag v1.0.6 // indirect
+`)
+	writeFile(t, filepath.Join(dir, "internal", "lib", "lib.go"), "// Package lib is not the main package.\npackage lib\n")
+	writeFile(t, filepath.Join(dir, "cmd", "my-tool", "main.go"), `// My-tool turns project specs into
+// documentation.
+//
+// It works offline.
+package main
+
+func main() {}
+`)
+
+	facts := &Facts{}
+	if err := detectGo(dir, facts); err != nil {
+		t.Fatalf("detectGo failed: %v", err)
+	}
+
+	if facts.TechStack.Language != "Go" {
+		t.Errorf("expected language Go, got %q", facts.TechStack.Language)
+	}
+	if !slices.Equal(facts.TechStack.Dependencies, []string{"github.com/spf13/cobra", "gopkg.in/yaml.v3"}) {
+		t.Errorf("expected direct dependencies only, got %v", facts.TechStack.Dependencies)
+	}
+	if !slices.Equal(facts.Prerequisites, []string{"Go 1.22 or later"}) {
+		t.Errorf("unexpected prerequisites: %v", facts.Prerequisites)
+	}
+	if len(facts.PackageManagers) != 1 || facts.PackageManagers[0].Command != "go install github.com/janedoe/my-tool/cmd/my-tool@latest" {
+		t.Errorf("unexpected package managers: %+v", facts.PackageManagers)
+	}
+	if facts.BuildCmd != "go build ./cmd/my-tool" {
+		t.Errorf("unexpected build command: %q", facts.BuildCmd)
+	}
+	if facts.Description != "My-tool turns project specs into documentation. It works offline." {
+		t.Errorf("unexpected description: %q", facts.Description)
+	}
+}
+
+func TestDetectGo_Frameworks(t *testing.T) {
+	// a module requiring several frameworks always gets the preferred one
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), `module example.com/api
+
+go 1.22
+
+require (
+	github.com/gofiber/fiber/v2 v2.52.5
+	github.com/gin-gonic/gin v1.10.0
+)
+`)
+
+	facts := &Facts{}
+	if err := detectGo(dir, facts); err != nil {
+		t.Fatalf("detectGo failed: %v", err)
+	}
+	if facts.TechStack.Framework != "Gin" || facts.BackendFramework != "Gin" {
+		t.Errorf("expected Gin, got %q and %q", facts.TechStack.Framework, facts.BackendFramework)
+	}
+}
+
+func TestDetectGo_NoModule(t *testing.T) {
+	facts := &Facts{}
+	if err := detectGo(t.TempDir(), facts); err != nil {
+		t.Fatalf("detectGo failed: %v", err)
+	}
+	if facts.TechStack.Language != "" {
+		t.Errorf("expected no language without go.mod, got %q", facts.TechStack.Language)
+	}
+}
+
+func TestDocSynopsis_LongParagraph(t *testing.T) {
+	doc := strings.Repeat("word ", 150)
+	synopsis := docSynopsis(doc)
+	if len(synopsis) > maxDescription || strings.HasSuffix(synopsis, " ") {
+		t.Errorf("expected synopsis cut at a word within %d characters, got %d", maxDescription, len(synopsis))
+	}
+}
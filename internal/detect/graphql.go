@@ -0,0 +1,45 @@
+package detect
+
+import (
+	"path/filepath"
+
+	"github.com/bycait27/readme-generator/internal/importer"
+)
+
+func init() {
+	Register(Detector{Name: "graphql", Detect: detectGraphQL})
+}
+
+// graphQLSchemaPatterns are where GraphQL servers usually keep their SDL,
+// gqlgen's graph/ directory among them
+var graphQLSchemaPatterns = []string{"schema.graphql", "*.graphqls", "graph/*.graphqls", "graph/*.graphql"}
+
+// detectGraphQL proposes GraphQL operations and types from the schema
+// files of the project
+func detectGraphQL(dir string, facts *Facts) error {
+	if facts.GraphQL != nil {
+		return nil
+	}
+
+	var paths []string
+	for _, pattern := range graphQLSchemaPatterns {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		paths = append(paths, matches...)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	docs, err := importer.ImportGraphQL(paths...)
+	if err != nil {
+		return err
+	}
+	if schema, err := filepath.Rel(dir, docs.Schema); err == nil {
+		docs.Schema = schema
+	}
+	facts.GraphQL = docs
+	return nil
+}
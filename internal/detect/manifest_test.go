@@ -0,0 +1,144 @@
+package detect
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestDetectNode(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "package.json"), `{
+  "name": "shop-web",
+  "description": "Storefront for the shop",
+  "scripts": {"dev": "next dev", "build": "next build", "test": "vitest"},
+  "dependencies": {"next": "14.0.0", "react": "18.2.0", "express": "4.18.0"},
+  "devDependencies": {"typescript": "5.0.0", "vitest": "1.0.0"},
+  "engines": {"node": ">=18"}
+}`)
+	writeFile(t, filepath.Join(dir, "yarn.lock"), "")
+
+	facts := &Facts{}
+	if err := detectNode(dir, facts); err != nil {
+		t.Fatalf("detectNode failed: %v", err)
+	}
+
+	if facts.TechStack.Language != "TypeScript" || facts.TechStack.Framework != "Next.js" {
+		t.Errorf("unexpected tech stack: %+v", facts.TechStack)
+	}
+	if facts.FrontendFramework != "Next.js" || facts.BackendFramework != "Express" {
+		t.Errorf("unexpected frameworks: %q, %q", facts.FrontendFramework, facts.BackendFramework)
+	}
+	if !slices.Equal(facts.TechStack.Dependencies, []string{"express", "next", "react"}) {
+		t.Errorf("unexpected dependencies: %v", facts.TechStack.Dependencies)
+	}
+	if !slices.Equal(facts.RunCommands, []string{"yarn install", "yarn run dev"}) {
+		t.Errorf("unexpected run commands: %v", facts.RunCommands)
+	}
+	if facts.TestCommand != "yarn test" || facts.TestFramework != "Vitest" {
+		t.Errorf("unexpected testing: %q, %q", facts.TestCommand, facts.TestFramework)
+	}
+	if !slices.Equal(facts.Prerequisites, []string{"Node.js >=18"}) {
+		t.Errorf("unexpected prerequisites: %v", facts.Prerequisites)
+	}
+}
+
+func TestDetectNode_DefaultTestScript(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "package.json"), `{"scripts": {"test": "echo \"Error: no test specified\" && exit 1"}}`)
+
+	facts := &Facts{}
+	if err := detectNode(dir, facts); err != nil {
+		t.Fatalf("detectNode failed: %v", err)
+	}
+	if facts.TestCommand != "" {
+		t.Errorf("expected the npm placeholder test script to be ignored, got %q", facts.TestCommand)
+	}
+}
+
+func TestDetectPython(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "pyproject.toml"), `[project]
+name = "orders-api"
+requires-python = ">=3.11"
+dependencies = ["Django[argon2]>=4.2", "psycopg ; python_version >= '3.11'"]
+`)
+	writeFile(t, filepath.Join(dir, "requirements.txt"), "# pinned\n-r base.txt\npytest==8.0.0\n")
+	writeFile(t, filepath.Join(dir, "manage.py"), "")
+
+	facts := &Facts{}
+	if err := detectPython(dir, facts); err != nil {
+		t.Fatalf("detectPython failed: %v", err)
+	}
+
+	if facts.TechStack.Language != "Python" || facts.BackendFramework != "Django" {
+		t.Errorf("unexpected tech stack: %+v, backend %q", facts.TechStack, facts.BackendFramework)
+	}
+	if !slices.Equal(facts.TechStack.Dependencies, []string{"Django", "psycopg", "pytest"}) {
+		t.Errorf("unexpected dependencies: %v", facts.TechStack.Dependencies)
+	}
+	if !slices.Equal(facts.RunCommands, []string{"pip install -r requirements.txt", "python manage.py runserver"}) {
+		t.Errorf("unexpected run commands: %v", facts.RunCommands)
+	}
+	if facts.TestCommand != "pytest" {
+		t.Errorf("expected pytest, got %q", facts.TestCommand)
+	}
+	if !slices.Equal(facts.Prerequisites, []string{"Python >=3.11"}) {
+		t.Errorf("unexpected prerequisites: %v", facts.Prerequisites)
+	}
+}
+
+func TestDetectRust(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "Cargo.toml"), `[package]
+name = "ripfind"
+description = "Find files fast"
+rust-version = "1.74"
+
+[dependencies]
+clap = { version = "4", features = ["derive"] }
+anyhow = "1"
+`)
+	writeFile(t, filepath.Join(dir, "src", "main.rs"), "fn main() {}\n")
+
+	facts := &Facts{}
+	if err := detectRust(dir, facts); err != nil {
+		t.Fatalf("detectRust failed: %v", err)
+	}
+
+	if facts.TechStack.Language != "Rust" || facts.TechStack.Framework != "clap" {
+		t.Errorf("unexpected tech stack: %+v", facts.TechStack)
+	}
+	if len(facts.PackageManagers) != 1 || facts.PackageManagers[0].Command != "cargo install ripfind" {
+		t.Errorf("unexpected package managers: %+v", facts.PackageManagers)
+	}
+	if facts.TestCommand != "cargo test" || facts.Description != "Find files fast" {
+		t.Errorf("unexpected facts: %+v", *facts)
+	}
+}
+
+func TestApply_FullStackSuggestions(t *testing.T) {
+	facts := &Facts{
+		RunCommands:       []string{"npm install", "npm run dev"},
+		TestCommand:       "npm test",
+		FrontendFramework: "React",
+		BackendFramework:  "Express",
+	}
+	app := &models.FullStackApp{
+		GettingStarted: models.GettingStarted{RunCommands: []string{"make dev"}},
+	}
+
+	Apply(facts, app)
+
+	if !slices.Equal(app.GettingStarted.RunCommands, []string{"make dev"}) {
+		t.Errorf("expected existing run commands to be kept, got %v", app.GettingStarted.RunCommands)
+	}
+	if app.Testing == nil || app.Testing.TestCommand != "npm test" {
+		t.Errorf("expected test command to be suggested, got %+v", app.Testing)
+	}
+	if app.AppStructure == nil || app.AppStructure.Frontend.Framework != "React" || app.AppStructure.Backend.Framework != "Express" {
+		t.Errorf("expected frameworks to be suggested, got %+v", app.AppStructure)
+	}
+}
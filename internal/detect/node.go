@@ -0,0 +1,142 @@
+package detect
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func init() {
+	Register(Detector{Name: "node", Detect: detectNode})
+}
+
+// nodeFrontends is ordered so meta-frameworks win over the library they
+// are built on
+var nodeFrontends = []framework{
+	{"next", "Next.js"},
+	{"nuxt", "Nuxt.js"},
+	{"@angular/core", "Angular"},
+	{"react", "React"},
+	{"vue", "Vue"},
+	{"svelte", "Svelte"},
+}
+
+var nodeBackends = []framework{
+	{"express", "Express"},
+}
+
+var nodeTestFrameworks = []framework{
+	{"vitest", "Vitest"},
+	{"jest", "Jest"},
+	{"mocha", "Mocha"},
+}
+
+// the test script npm init writes
+const npmDefaultTest = `echo "Error: no test specified" && exit 1`
+
+type packageJSON struct {
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	Bin             json.RawMessage   `json:"bin"`
+	Scripts         map[string]string `json:"scripts"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+	Engines         map[string]string `json:"engines"`
+}
+
+// detectNode reads package.json for the language, frameworks, scripts and
+// dependencies of a Node project
+func detectNode(dir string, facts *Facts) error {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil // not a Node project
+	}
+	if err != nil {
+		return err
+	}
+
+	var pkg packageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return err
+	}
+
+	hasDependency := func(name string) bool {
+		_, inDeps := pkg.Dependencies[name]
+		_, inDevDeps := pkg.DevDependencies[name]
+		return inDeps || inDevDeps
+	}
+
+	// tech stack
+	language := "JavaScript"
+	if hasDependency("typescript") || fileExists(filepath.Join(dir, "tsconfig.json")) {
+		language = "TypeScript"
+	}
+	setIfEmpty(&facts.TechStack.Language, language)
+
+	frontend := matchFramework(nodeFrontends, hasDependency)
+	backend := matchFramework(nodeBackends, hasDependency)
+	setIfEmpty(&facts.FrontendFramework, frontend)
+	setIfEmpty(&facts.BackendFramework, backend)
+	setIfEmpty(&facts.TechStack.Framework, frontend)
+	setIfEmpty(&facts.TechStack.Framework, backend)
+
+	dependencies := make([]string, 0, len(pkg.Dependencies))
+	for name := range pkg.Dependencies {
+		dependencies = append(dependencies, name)
+	}
+	slices.Sort(dependencies)
+	appendIfEmpty(&facts.TechStack.Dependencies, dependencies)
+
+	// getting started
+	if version := pkg.Engines["node"]; version != "" {
+		appendIfEmpty(&facts.Prerequisites, []string{"Node.js " + version})
+	} else {
+		appendIfEmpty(&facts.Prerequisites, []string{"Node.js"})
+	}
+
+	npm := nodePackageManager(dir)
+	runCommands := []string{npm + " install"}
+	switch {
+	case pkg.Scripts["dev"] != "":
+		runCommands = append(runCommands, npm+" run dev")
+	case pkg.Scripts["start"] != "":
+		runCommands = append(runCommands, npm+" start")
+	}
+	appendIfEmpty(&facts.RunCommands, runCommands)
+
+	if test := pkg.Scripts["test"]; test != "" && test != npmDefaultTest {
+		setIfEmpty(&facts.TestCommand, npm+" test")
+		setIfEmpty(&facts.TestFramework, matchFramework(nodeTestFrameworks, hasDependency))
+	}
+	if pkg.Scripts["build"] != "" {
+		setIfEmpty(&facts.BuildCmd, npm+" run build")
+	}
+
+	// packages with a bin entry can be installed as a command
+	if len(pkg.Bin) > 0 && pkg.Name != "" {
+		appendIfEmpty(&facts.PackageManagers, []models.PackageManager{
+			{Name: "npm", Command: "npm install -g " + pkg.Name},
+		})
+	}
+
+	setIfEmpty(&facts.Title, pkg.Name)
+	setIfEmpty(&facts.Description, pkg.Description)
+
+	return nil
+}
+
+// nodePackageManager guesses npm, yarn or pnpm from the lock file
+func nodePackageManager(dir string) string {
+	switch {
+	case fileExists(filepath.Join(dir, "pnpm-lock.yaml")):
+		return "pnpm"
+	case fileExists(filepath.Join(dir, "yarn.lock")):
+		return "yarn"
+	default:
+		return "npm"
+	}
+}
@@ -0,0 +1,171 @@
+package detect
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func init() {
+	Register(Detector{Name: "python", Detect: detectPython})
+}
+
+var pythonBackends = []framework{
+	{"django", "Django"},
+	{"fastapi", "FastAPI"},
+	{"flask", "Flask"},
+}
+
+type pyproject struct {
+	Project struct {
+		Name           string            `toml:"name"`
+		Description    string            `toml:"description"`
+		RequiresPython string            `toml:"requires-python"`
+		Dependencies   []string          `toml:"dependencies"`
+		Scripts        map[string]string `toml:"scripts"`
+	} `toml:"project"`
+	Tool struct {
+		Poetry struct {
+			Name         string                 `toml:"name"`
+			Description  string                 `toml:"description"`
+			Dependencies map[string]interface{} `toml:"dependencies"`
+			Scripts      map[string]interface{} `toml:"scripts"`
+		} `toml:"poetry"`
+		Pytest map[string]interface{} `toml:"pytest"`
+	} `toml:"tool"`
+}
+
+// detectPython reads pyproject.toml and requirements.txt for the
+// dependencies and web framework of a Python project
+func detectPython(dir string, facts *Facts) error {
+	var project pyproject
+	_, err := toml.DecodeFile(filepath.Join(dir, "pyproject.toml"), &project)
+	hasPyproject := err == nil
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	requirements, err := readRequirements(filepath.Join(dir, "requirements.txt"))
+	if err != nil {
+		return err
+	}
+	if !hasPyproject && requirements == nil {
+		return nil // not a Python project
+	}
+
+	// dependencies from every source, in the order they were declared
+	var dependencies []string
+	pythonVersion := project.Project.RequiresPython
+	for _, requirement := range project.Project.Dependencies {
+		dependencies = append(dependencies, requirementName(requirement))
+	}
+	var poetryDependencies []string
+	for name, constraint := range project.Tool.Poetry.Dependencies {
+		if name == "python" {
+			if version, ok := constraint.(string); ok && pythonVersion == "" {
+				pythonVersion = version
+			}
+			continue
+		}
+		poetryDependencies = append(poetryDependencies, name)
+	}
+	slices.Sort(poetryDependencies)
+	dependencies = append(dependencies, poetryDependencies...)
+	dependencies = append(dependencies, requirements...)
+	hasDependency := hasName(dependencies)
+
+	// tech stack
+	setIfEmpty(&facts.TechStack.Language, "Python")
+	backend := matchFramework(pythonBackends, hasDependency)
+	setIfEmpty(&facts.BackendFramework, backend)
+	setIfEmpty(&facts.TechStack.Framework, backend)
+	appendIfEmpty(&facts.TechStack.Dependencies, dependencies)
+
+	// getting started
+	prerequisite := "Python 3"
+	if pythonVersion != "" {
+		prerequisite = "Python " + pythonVersion
+	}
+	appendIfEmpty(&facts.Prerequisites, []string{prerequisite})
+
+	install := "pip install ."
+	if requirements != nil {
+		install = "pip install -r requirements.txt"
+	}
+	runCommands := []string{install}
+	switch {
+	case backend == "Django" && fileExists(filepath.Join(dir, "manage.py")):
+		runCommands = append(runCommands, "python manage.py runserver")
+	case backend == "Flask":
+		runCommands = append(runCommands, "flask run")
+	}
+	appendIfEmpty(&facts.RunCommands, runCommands)
+
+	switch {
+	case hasDependency("pytest") || project.Tool.Pytest != nil || fileExists(filepath.Join(dir, "pytest.ini")):
+		setIfEmpty(&facts.TestCommand, "pytest")
+		setIfEmpty(&facts.TestFramework, "pytest")
+	case backend == "Django":
+		setIfEmpty(&facts.TestCommand, "python manage.py test")
+	}
+
+	// projects with console scripts can be installed as a command
+	name := project.Project.Name
+	if name == "" {
+		name = project.Tool.Poetry.Name
+	}
+	if name != "" && (len(project.Project.Scripts) > 0 || len(project.Tool.Poetry.Scripts) > 0) {
+		appendIfEmpty(&facts.PackageManagers, []models.PackageManager{
+			{Name: "pip", Command: "pip install " + name},
+		})
+	}
+
+	setIfEmpty(&facts.Title, name)
+	setIfEmpty(&facts.Description, project.Project.Description)
+	setIfEmpty(&facts.Description, project.Tool.Poetry.Description)
+
+	return nil
+}
+
+// readRequirements lists the package names in a requirements file. It
+// returns nil when the file doesn't exist.
+func readRequirements(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	names := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "-") {
+			continue // options such as -r and -e
+		}
+		if name := requirementName(line); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, scanner.Err()
+}
+
+// requirementName strips the version, extras and markers from a PEP 508
+// requirement such as `django[argon2]>=4.2; python_version >= "3.10"`
+func requirementName(requirement string) string {
+	end := strings.IndexAny(requirement, "<>=!~[;@ ")
+	if end < 0 {
+		return strings.TrimSpace(requirement)
+	}
+	return strings.TrimSpace(requirement[:end])
+}
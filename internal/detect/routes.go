@@ -0,0 +1,18 @@
+package detect
+
+import "github.com/bycait27/readme-generator/internal/importer"
+
+func init() {
+	Register(Detector{Name: "routes", Detect: detectRoutes})
+}
+
+// detectRoutes proposes endpoints from the HTTP routes registered in Go
+// source
+func detectRoutes(dir string, facts *Facts) error {
+	endpoints, err := importer.GoRoutes(dir)
+	if err != nil {
+		return err
+	}
+	appendIfEmpty(&facts.Endpoints, endpoints)
+	return nil
+}
@@ -0,0 +1,82 @@
+package detect
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/BurntSushi/toml"
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func init() {
+	Register(Detector{Name: "rust", Detect: detectRust})
+}
+
+// rustFrameworks aren't among the FrontendStructure or BackendStructure
+// options, so they only fill in the tech stack
+var rustFrameworks = []framework{
+	{"axum", "Axum"},
+	{"actix-web", "Actix Web"},
+	{"rocket", "Rocket"},
+	{"clap", "clap"},
+}
+
+type cargoManifest struct {
+	Package struct {
+		Name        string `toml:"name"`
+		Description string `toml:"description"`
+		RustVersion string `toml:"rust-version"`
+	} `toml:"package"`
+	Dependencies map[string]interface{} `toml:"dependencies"`
+	Bin          []struct {
+		Name string `toml:"name"`
+	} `toml:"bin"`
+}
+
+// detectRust reads Cargo.toml for the dependencies and commands of a Rust
+// crate
+func detectRust(dir string, facts *Facts) error {
+	var manifest cargoManifest
+	_, err := toml.DecodeFile(filepath.Join(dir, "Cargo.toml"), &manifest)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil // not a Rust crate
+	}
+	if err != nil {
+		return err
+	}
+
+	// tech stack
+	setIfEmpty(&facts.TechStack.Language, "Rust")
+	dependencies := make([]string, 0, len(manifest.Dependencies))
+	for name := range manifest.Dependencies {
+		dependencies = append(dependencies, name)
+	}
+	slices.Sort(dependencies)
+	setIfEmpty(&facts.TechStack.Framework, matchFramework(rustFrameworks, hasName(dependencies)))
+	appendIfEmpty(&facts.TechStack.Dependencies, dependencies)
+
+	// getting started
+	prerequisite := "Rust (stable)"
+	if manifest.Package.RustVersion != "" {
+		prerequisite = "Rust " + manifest.Package.RustVersion + " or later"
+	}
+	appendIfEmpty(&facts.Prerequisites, []string{prerequisite})
+	appendIfEmpty(&facts.RunCommands, []string{"cargo run"})
+	setIfEmpty(&facts.TestCommand, "cargo test")
+	setIfEmpty(&facts.BuildCmd, "cargo build --release")
+
+	// binary crates can be installed as a command
+	isBinary := len(manifest.Bin) > 0 || fileExists(filepath.Join(dir, "src", "main.rs"))
+	if isBinary && manifest.Package.Name != "" {
+		appendIfEmpty(&facts.PackageManagers, []models.PackageManager{
+			{Name: "cargo", Command: "cargo install " + manifest.Package.Name},
+		})
+	}
+
+	setIfEmpty(&facts.Title, manifest.Package.Name)
+	setIfEmpty(&facts.Description, manifest.Package.Description)
+
+	return nil
+}
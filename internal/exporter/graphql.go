@@ -0,0 +1,214 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// maxSelectionDepth limits how deep example selection sets go into
+// nested object types
+const maxSelectionDepth = 2
+
+// GraphQLExample is an operation with an example document that runs it
+type GraphQLExample struct {
+	models.GraphQLOperation
+	Kind      string // query, mutation or subscription
+	Document  string // the operation's Example, or one built from the schema
+	Variables string // JSON variables for Document, empty when it has none
+	Curl      string // POST request running Document, empty for subscriptions
+}
+
+// GraphQLExamples builds an example for each operation of a kind: query,
+// mutation or subscription. Documents declare a variable for every
+// argument without a default and select the scalar fields of the result,
+// going up to two object types deep.
+func GraphQLExamples(docs *models.GraphQLDocs, auth *models.Auth, kind string) []GraphQLExample {
+	if docs == nil {
+		return nil
+	}
+
+	var operations []models.GraphQLOperation
+	switch kind {
+	case "query":
+		operations = docs.Queries
+	case "mutation":
+		operations = docs.Mutations
+	case "subscription":
+		operations = docs.Subscriptions
+	}
+
+	s := graphQLSchema{types: map[string]models.GraphQLType{}}
+	for _, graphQLType := range docs.Types {
+		s.types[graphQLType.Name] = graphQLType
+	}
+
+	var examples []GraphQLExample
+	for _, operation := range operations {
+		example := GraphQLExample{GraphQLOperation: operation, Kind: kind}
+		variables := s.variables(operation)
+		if operation.Example != "" {
+			example.Document = strings.TrimSpace(operation.Example)
+		} else {
+			example.Document = s.document(kind, operation)
+		}
+		if len(variables.keys) > 0 {
+			data, _ := json.MarshalIndent(variables, "", "  ")
+			example.Variables = string(data)
+		}
+
+		if kind != "subscription" && docs.Endpoint != "" {
+			body := &orderedObject{keys: []string{"query"}, values: []interface{}{example.Document}}
+			if len(variables.keys) > 0 {
+				body.keys = append(body.keys, "variables")
+				body.values = append(body.values, variables)
+			}
+			data, _ := json.Marshal(body)
+			request := Request{Method: "POST", URL: docs.Endpoint, Body: string(data)}
+			if header, _, ok := AuthHeader(auth); ok {
+				request.Headers = append(request.Headers, models.Header{Name: header.Name, Value: fillPlaceholders(header.Value)})
+			}
+			request.Headers = append(request.Headers, models.Header{Name: "Content-Type", Value: "application/json"})
+			example.Curl = CurlSnippet(request)
+		}
+		examples = append(examples, example)
+	}
+	return examples
+}
+
+// graphQLSchema looks up the documented types by name
+type graphQLSchema struct {
+	types map[string]models.GraphQLType
+}
+
+// document renders an operation such as
+//
+//	query Pet($id: ID!) {
+//	  pet(id: $id) {
+//	    id
+//	  }
+//	}
+func (s graphQLSchema) document(kind string, operation models.GraphQLOperation) string {
+	var declarations, arguments []string
+	for _, argument := range operation.Arguments {
+		if argument.Default != "" {
+			continue
+		}
+		declarations = append(declarations, "$"+argument.Name+": "+argument.Type)
+		arguments = append(arguments, argument.Name+": $"+argument.Name)
+	}
+
+	var b strings.Builder
+	b.WriteString(kind + " " + strings.ToUpper(operation.Name[:1]) + operation.Name[1:])
+	if len(declarations) > 0 {
+		b.WriteString("(" + strings.Join(declarations, ", ") + ")")
+	}
+	b.WriteString(" {\n  " + operation.Name)
+	if len(arguments) > 0 {
+		b.WriteString("(" + strings.Join(arguments, ", ") + ")")
+	}
+	set, _ := s.selection(operation.Type, "  ", 0)
+	b.WriteString(set)
+	b.WriteString("\n}")
+	return b.String()
+}
+
+// selection renders the selection set of a field of the given type, which
+// is empty for scalars and enums. ok is false when an object type has no
+// fields to select at this depth, leaving just __typename.
+func (s graphQLSchema) selection(reference, indent string, depth int) (set string, ok bool) {
+	name := namedType(reference)
+	graphQLType, known := s.types[name]
+	switch {
+	case isBuiltinScalar(name), known && (graphQLType.Kind == "scalar" || graphQLType.Kind == "enum"):
+		return "", true
+	case !known:
+		// a type that wasn't documented still needs a selection
+		return " {\n" + indent + "  __typename\n" + indent + "}", false
+	}
+
+	var lines []string
+	if graphQLType.Kind == "union" {
+		lines = append(lines, indent+"  __typename")
+		for _, member := range graphQLType.Values {
+			if nested, ok := s.selection(member, indent+"  ", depth+1); ok && depth < maxSelectionDepth {
+				lines = append(lines, indent+"  ... on "+member+nested)
+			}
+		}
+	}
+	for _, field := range graphQLType.Fields {
+		nested, ok := s.selection(field.Type, indent+"  ", depth+1)
+		if nested == "" || ok && depth < maxSelectionDepth {
+			lines = append(lines, indent+"  "+field.Name+nested)
+		}
+	}
+
+	ok = len(lines) > 1 || len(lines) == 1 && graphQLType.Kind != "union"
+	if len(lines) == 0 {
+		lines = append(lines, indent+"  __typename")
+	}
+	return " {\n" + strings.Join(lines, "\n") + "\n" + indent + "}", ok
+}
+
+// variables builds example values for the arguments without a default
+func (s graphQLSchema) variables(operation models.GraphQLOperation) *orderedObject {
+	variables := &orderedObject{}
+	for _, argument := range operation.Arguments {
+		if argument.Default == "" {
+			variables.keys = append(variables.keys, argument.Name)
+			variables.values = append(variables.values, s.exampleValue(argument.Type, 0))
+		}
+	}
+	return variables
+}
+
+// exampleValue makes up a value of a type, filling the fields of input
+// objects
+func (s graphQLSchema) exampleValue(reference string, depth int) interface{} {
+	reference = strings.TrimSuffix(reference, "!")
+	if strings.HasPrefix(reference, "[") {
+		return []interface{}{s.exampleValue(strings.TrimSuffix(strings.TrimPrefix(reference, "["), "]"), depth)}
+	}
+
+	switch reference {
+	case "ID":
+		return "1"
+	case "String":
+		return "text"
+	case "Int":
+		return 1
+	case "Float":
+		return 1.5
+	case "Boolean":
+		return true
+	}
+
+	graphQLType := s.types[reference]
+	switch {
+	case graphQLType.Kind == "enum" && len(graphQLType.Values) > 0:
+		return graphQLType.Values[0]
+	case graphQLType.Kind == "input" && depth < maxSelectionDepth:
+		object := &orderedObject{}
+		for _, field := range graphQLType.Fields {
+			object.keys = append(object.keys, field.Name)
+			object.values = append(object.values, s.exampleValue(field.Type, depth+1))
+		}
+		return object
+	}
+	return fmt.Sprintf("<%s>", reference)
+}
+
+func isBuiltinScalar(name string) bool {
+	switch name {
+	case "ID", "String", "Int", "Float", "Boolean":
+		return true
+	}
+	return false
+}
+
+// namedType strips list and non-null wrappers from a type reference
+func namedType(reference string) string {
+	return strings.Trim(reference, "[]! ")
+}
@@ -0,0 +1,120 @@
+package exporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func petGraphQL() *models.GraphQLDocs {
+	return &models.GraphQLDocs{
+		Endpoint: "https://api.example.com/graphql",
+		Queries: []models.GraphQLOperation{
+			{Name: "pet", Type: "Pet", Arguments: []models.GraphQLArgument{{Name: "id", Type: "ID!"}}},
+			{Name: "search", Type: "[SearchResult!]!", Arguments: []models.GraphQLArgument{
+				{Name: "text", Type: "String!"},
+				{Name: "first", Type: "Int", Default: "10"},
+			}},
+		},
+		Mutations: []models.GraphQLOperation{
+			{Name: "addPet", Type: "Pet!", Arguments: []models.GraphQLArgument{{Name: "input", Type: "PetInput!"}}},
+		},
+		Subscriptions: []models.GraphQLOperation{
+			{Name: "petAdded", Type: "Pet!"},
+		},
+		Types: []models.GraphQLType{
+			{Name: "Pet", Kind: "type", Fields: []models.GraphQLField{
+				{Name: "id", Type: "ID!"},
+				{Name: "name", Type: "String!"},
+				{Name: "status", Type: "Status"},
+				{Name: "owner", Type: "Owner"},
+			}},
+			{Name: "Owner", Kind: "type", Fields: []models.GraphQLField{{Name: "name", Type: "String"}}},
+			{Name: "Store", Kind: "type", Fields: []models.GraphQLField{{Name: "city", Type: "String"}}},
+			{Name: "SearchResult", Kind: "union", Values: []string{"Pet", "Store"}},
+			{Name: "Status", Kind: "enum", Values: []string{"AVAILABLE", "SOLD"}},
+			{Name: "PetInput", Kind: "input", Fields: []models.GraphQLField{
+				{Name: "name", Type: "String!"},
+				{Name: "status", Type: "Status"},
+			}},
+		},
+	}
+}
+
+func TestGraphQLExamples_Query(t *testing.T) {
+	auth := &models.Auth{Method: "jwt"}
+	examples := GraphQLExamples(petGraphQL(), auth, "query")
+	if len(examples) != 2 {
+		t.Fatalf("GraphQLExamples() returned %d examples, want 2", len(examples))
+	}
+
+	pet := examples[0]
+	wantDocument := "query Pet($id: ID!) {\n  pet(id: $id) {\n    id\n    name\n    status\n    owner {\n      name\n    }\n  }\n}"
+	if pet.Document != wantDocument {
+		t.Errorf("Document = %q, want %q", pet.Document, wantDocument)
+	}
+	if pet.Variables != "{\n  \"id\": \"1\"\n}" {
+		t.Errorf("Variables = %q", pet.Variables)
+	}
+	for _, want := range []string{
+		"curl -X POST 'https://api.example.com/graphql'",
+		"-H 'Authorization: Bearer <token>'",
+		`"variables":{"id":"1"}`,
+	} {
+		if !strings.Contains(pet.Curl, want) {
+			t.Errorf("Curl missing %q in:\n%s", want, pet.Curl)
+		}
+	}
+
+	// arguments with a default are left out and unions select per member
+	search := examples[1]
+	for _, want := range []string{
+		"query Search($text: String!) {",
+		"search(text: $text) {",
+		"__typename",
+		"... on Pet {",
+		"... on Store {",
+	} {
+		if !strings.Contains(search.Document, want) {
+			t.Errorf("Document missing %q in:\n%s", want, search.Document)
+		}
+	}
+}
+
+func TestGraphQLExamples_Mutation(t *testing.T) {
+	examples := GraphQLExamples(petGraphQL(), nil, "mutation")
+	if len(examples) != 1 {
+		t.Fatalf("GraphQLExamples() returned %d examples, want 1", len(examples))
+	}
+
+	want := "{\n  \"input\": {\n    \"name\": \"text\",\n    \"status\": \"AVAILABLE\"\n  }\n}"
+	if examples[0].Variables != want {
+		t.Errorf("Variables = %q, want %q", examples[0].Variables, want)
+	}
+	if strings.Contains(examples[0].Curl, "Authorization") {
+		t.Errorf("Curl has an auth header without auth:\n%s", examples[0].Curl)
+	}
+}
+
+func TestGraphQLExamples_Subscription(t *testing.T) {
+	examples := GraphQLExamples(petGraphQL(), nil, "subscription")
+	if len(examples) != 1 {
+		t.Fatalf("GraphQLExamples() returned %d examples, want 1", len(examples))
+	}
+	if examples[0].Curl != "" {
+		t.Errorf("Curl = %q, want none for a subscription", examples[0].Curl)
+	}
+	if examples[0].Variables != "" {
+		t.Errorf("Variables = %q, want none", examples[0].Variables)
+	}
+}
+
+func TestGraphQLExamples_Example(t *testing.T) {
+	docs := petGraphQL()
+	docs.Queries[0].Example = "  { pet(id: 1) { name } }\n"
+	examples := GraphQLExamples(docs, nil, "query")
+	if examples[0].Document != "{ pet(id: 1) { name } }" {
+		t.Errorf("Document = %q, want the given example", examples[0].Document)
+	}
+}
@@ -0,0 +1,197 @@
+package exporter
+
+import (
+	"encoding/json"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// wellKnownExamples are JSON values for the google.protobuf types that
+// have a special JSON mapping
+var wellKnownExamples = map[string]interface{}{
+	"google.protobuf.Timestamp":   "2024-01-01T00:00:00Z",
+	"google.protobuf.Duration":    "1.5s",
+	"google.protobuf.FieldMask":   "name",
+	"google.protobuf.StringValue": "text",
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.Int32Value":  1,
+	"google.protobuf.DoubleValue": 1.5,
+}
+
+// GRPCExample is a method with a grpcurl command that calls it
+type GRPCExample struct {
+	models.GRPCMethod
+	Service  string              // full service name
+	Mode     string              // unary, server streaming, client streaming or bidirectional streaming
+	Request  *models.GRPCMessage // nil when the message isn't documented
+	Response *models.GRPCMessage // nil when the message isn't documented
+	Grpcurl  string
+}
+
+// GRPCExamples builds an example for each method of a service. The
+// grpcurl commands connect to the host and port of the gRPC address, or
+// else the base URL, in
+// plaintext unless its scheme is https or grpcs, and send a request with
+// a value for every field.
+func GRPCExamples(docs models.APIDocs, auth *models.Auth, service models.GRPCService) []GRPCExample {
+	if docs.GRPC == nil {
+		return nil
+	}
+	s := protoSchema{messages: map[string]models.GRPCMessage{}}
+	for _, message := range docs.GRPC.Messages {
+		s.messages[message.Name] = message
+	}
+
+	address, plaintext := grpcAddress(firstNonEmpty(docs.GRPC.Address, docs.BaseURL))
+	var examples []GRPCExample
+	for _, method := range service.Methods {
+		example := GRPCExample{GRPCMethod: method, Service: service.Name, Mode: streamingMode(method)}
+		if message, ok := s.messages[method.Request]; ok {
+			example.Request = &message
+		}
+		if message, ok := s.messages[method.Response]; ok {
+			example.Response = &message
+		}
+
+		args := []string{"grpcurl"}
+		if plaintext {
+			args = append(args, "-plaintext")
+		}
+		if proto := docs.GRPC.Proto; strings.HasSuffix(proto, ".proto") {
+			args = append(args, "-import-path "+shellQuote(filepath.Dir(proto)), "-proto "+shellQuote(filepath.Base(proto)))
+		}
+		if header, _, ok := AuthHeader(auth); ok {
+			args = append(args, "-H "+shellQuote(header.Name+": "+fillPlaceholders(header.Value)))
+		}
+		if request, ok := s.exampleValue(method.Request, 0).(*orderedObject); ok && len(request.keys) > 0 {
+			data, _ := json.Marshal(request)
+			args = append(args, "-d "+shellQuote(string(data)))
+		}
+		args = append(args, address+" "+service.Name+"/"+method.Name)
+		example.Grpcurl = args[0] + " " + strings.Join(args[1:], " \\\n  ")
+		examples = append(examples, example)
+	}
+	return examples
+}
+
+// GRPCTypes lists the documented messages and enums that aren't the
+// request or response of a method, such as nested messages and enums
+func GRPCTypes(docs *models.GRPCDocs) []models.GRPCMessage {
+	if docs == nil {
+		return nil
+	}
+	used := map[string]bool{}
+	for _, service := range docs.Services {
+		for _, method := range service.Methods {
+			used[method.Request] = true
+			used[method.Response] = true
+		}
+	}
+
+	var types []models.GRPCMessage
+	for _, message := range docs.Messages {
+		if !used[message.Name] {
+			types = append(types, message)
+		}
+	}
+	return types
+}
+
+// grpcAddress turns a base URL into the host:port grpcurl dials, reporting
+// whether the connection is plaintext. Ports default to 443 with TLS and
+// 80 without.
+func grpcAddress(baseURL string) (address string, plaintext bool) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return baseURL, true
+	}
+	plaintext = u.Scheme != "https" && u.Scheme != "grpcs"
+	if u.Port() != "" {
+		return u.Host, plaintext
+	}
+	if plaintext {
+		return u.Host + ":80", plaintext
+	}
+	return u.Host + ":443", plaintext
+}
+
+func streamingMode(method models.GRPCMethod) string {
+	switch {
+	case method.ClientStreaming && method.ServerStreaming:
+		return "bidirectional streaming"
+	case method.ClientStreaming:
+		return "client streaming"
+	case method.ServerStreaming:
+		return "server streaming"
+	}
+	return "unary"
+}
+
+// protoSchema looks up the documented messages by name
+type protoSchema struct {
+	messages map[string]models.GRPCMessage
+}
+
+// exampleValue makes up the JSON value of a field type, following the
+// protobuf JSON mapping. Only the first field of each oneof is set.
+func (s protoSchema) exampleValue(typ string, depth int) interface{} {
+	switch typ {
+	case "string":
+		return "text"
+	case "bytes":
+		return "dGV4dA=="
+	case "bool":
+		return true
+	case "double", "float":
+		return 1.5
+	case "int32", "uint32", "sint32", "fixed32", "sfixed32":
+		return 1
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64":
+		return "1" // 64-bit integers are strings in JSON
+	}
+	if value, ok := wellKnownExamples[typ]; ok {
+		return value
+	}
+	if strings.HasPrefix(typ, "map<") {
+		_, value, _ := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(typ, "map<"), ">"), ", ")
+		return &orderedObject{keys: []string{"key"}, values: []interface{}{s.exampleValue(value, depth)}}
+	}
+
+	message, ok := s.messages[typ]
+	switch {
+	case !ok:
+		return &orderedObject{}
+	case message.Kind == "enum":
+		// skip the zero value, which proto3 style reserves for unspecified
+		for i, value := range message.Values {
+			if i > 0 || len(message.Values) == 1 || !strings.HasSuffix(value, "UNSPECIFIED") {
+				return value
+			}
+		}
+		return nil
+	}
+
+	object := &orderedObject{}
+	if depth > maxSelectionDepth {
+		return object
+	}
+	oneofs := map[string]bool{}
+	for _, field := range message.Fields {
+		if field.Oneof != "" {
+			if oneofs[field.Oneof] {
+				continue
+			}
+			oneofs[field.Oneof] = true
+		}
+		value := s.exampleValue(field.Type, depth+1)
+		if field.Label == "repeated" {
+			value = []interface{}{value}
+		}
+		object.keys = append(object.keys, field.Name)
+		object.values = append(object.values, value)
+	}
+	return object
+}
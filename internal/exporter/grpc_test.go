@@ -0,0 +1,114 @@
+package exporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func petGRPC() models.APIDocs {
+	return models.APIDocs{
+		BaseURL:        "https://pets.example.com",
+		Authentication: "Bearer token",
+		GRPC: &models.GRPCDocs{
+			Proto: "proto/pets.proto",
+			Services: []models.GRPCService{{
+				Name: "pets.v1.PetService",
+				Methods: []models.GRPCMethod{
+					{Name: "GetPet", Request: "pets.v1.GetPetRequest", Response: "pets.v1.Pet"},
+					{Name: "WatchPets", Request: "google.protobuf.Empty", Response: "pets.v1.Pet", ServerStreaming: true},
+					{Name: "Chat", Request: "pets.v1.Pet", Response: "pets.v1.Pet", ClientStreaming: true, ServerStreaming: true},
+				},
+			}},
+			Messages: []models.GRPCMessage{
+				{Name: "pets.v1.GetPetRequest", Kind: "message", Fields: []models.GRPCField{{Name: "id", Type: "int64"}}},
+				{Name: "pets.v1.Pet", Kind: "message", Fields: []models.GRPCField{
+					{Name: "name", Type: "string"},
+					{Name: "kind", Type: "pets.v1.Kind"},
+					{Name: "tags", Type: "string", Label: "repeated"},
+					{Name: "owners", Type: "map<string, pets.v1.Owner>"},
+					{Name: "born", Type: "google.protobuf.Timestamp"},
+					{Name: "shelter", Type: "string", Oneof: "home"},
+					{Name: "owner", Type: "pets.v1.Owner", Oneof: "home"},
+				}},
+				{Name: "pets.v1.Kind", Kind: "enum", Values: []string{"KIND_UNSPECIFIED", "DOG", "CAT"}},
+				{Name: "pets.v1.Owner", Kind: "message", Fields: []models.GRPCField{{Name: "name", Type: "string"}}},
+			},
+		},
+	}
+}
+
+func TestGRPCExamples(t *testing.T) {
+	docs := petGRPC()
+	examples := GRPCExamples(docs, &models.Auth{Method: "jwt"}, docs.GRPC.Services[0])
+	if len(examples) != 3 {
+		t.Fatalf("GRPCExamples() returned %d examples, want 3", len(examples))
+	}
+
+	getPet := examples[0]
+	want := "grpcurl -import-path 'proto' \\\n  -proto 'pets.proto' \\\n  -H 'Authorization: Bearer <token>' \\\n" +
+		"  -d '{\"id\":\"1\"}' \\\n  pets.example.com:443 pets.v1.PetService/GetPet"
+	if getPet.Grpcurl != want {
+		t.Errorf("Grpcurl = %q, want %q", getPet.Grpcurl, want)
+	}
+	if getPet.Mode != "unary" || getPet.Request == nil || getPet.Response == nil || getPet.Response.Name != "pets.v1.Pet" {
+		t.Errorf("unexpected GetPet example: %+v", getPet)
+	}
+
+	// undocumented request messages are sent empty
+	watch := examples[1]
+	if watch.Mode != "server streaming" || watch.Request != nil || strings.Contains(watch.Grpcurl, "-d ") {
+		t.Errorf("unexpected WatchPets example: %+v", watch)
+	}
+
+	chat := examples[2]
+	if chat.Mode != "bidirectional streaming" {
+		t.Errorf("Mode = %q, want bidirectional streaming", chat.Mode)
+	}
+	body := `-d '{"name":"text","kind":"DOG","tags":["text"],"owners":{"key":{"name":"text"}},"born":"2024-01-01T00:00:00Z","shelter":"text"}'`
+	if !strings.Contains(chat.Grpcurl, body) {
+		t.Errorf("Grpcurl missing %s in:\n%s", body, chat.Grpcurl)
+	}
+}
+
+func TestGRPCExamples_Address(t *testing.T) {
+	// the gRPC server can listen apart from the REST base URL
+	docs := petGRPC()
+	docs.GRPC.Address = "grpc://localhost:50051"
+	examples := GRPCExamples(docs, nil, docs.GRPC.Services[0])
+	if !strings.Contains(examples[0].Grpcurl, "-plaintext") || !strings.HasSuffix(examples[0].Grpcurl, "localhost:50051 pets.v1.PetService/GetPet") {
+		t.Errorf("Grpcurl should call the gRPC address, got:\n%s", examples[0].Grpcurl)
+	}
+}
+
+func TestGRPCAddress(t *testing.T) {
+	tests := []struct {
+		baseURL   string
+		address   string
+		plaintext bool
+	}{
+		{"https://pets.example.com", "pets.example.com:443", false},
+		{"grpcs://pets.example.com:8443", "pets.example.com:8443", false},
+		{"grpc://localhost:50051", "localhost:50051", true},
+		{"http://localhost", "localhost:80", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.baseURL, func(t *testing.T) {
+			address, plaintext := grpcAddress(tt.baseURL)
+			if address != tt.address || plaintext != tt.plaintext {
+				t.Errorf("grpcAddress() = %q, %v, want %q, %v", address, plaintext, tt.address, tt.plaintext)
+			}
+		})
+	}
+}
+
+func TestGRPCTypes(t *testing.T) {
+	var names []string
+	for _, message := range GRPCTypes(petGRPC().GRPC) {
+		names = append(names, message.Name)
+	}
+	if got := strings.Join(names, ", "); got != "pets.v1.Kind, pets.v1.Owner" {
+		t.Errorf("GRPCTypes() = %s, want the messages not used by methods", got)
+	}
+}
@@ -0,0 +1,44 @@
+package exporter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// HTTPFile renders a request collection in the .http format read by the
+// VS Code REST Client and JetBrains HTTP Client, with one request per
+// endpoint. The base URL and credentials are file variables.
+func HTTPFile(docs models.APIDocs, auth *models.Auth) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "@baseUrl = %s\n", strings.TrimSuffix(docs.BaseURL, "/"))
+	if _, credential, _ := AuthHeader(auth); credential != "" {
+		fmt.Fprintf(&b, "@%s = <your %s>\n", credential, credential)
+	}
+
+	for _, endpoint := range docs.Endpoints {
+		request := BuildRequest("{{baseUrl}}", auth, endpoint)
+
+		b.WriteString("\n### ")
+		b.WriteString(firstLine(endpoint.Description, endpoint.Method+" "+endpoint.Path))
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "%s %s\n", request.Method, request.URL)
+		for _, header := range request.Headers {
+			fmt.Fprintf(&b, "%s: %s\n", header.Name, header.Value)
+		}
+		if request.Body != "" {
+			b.WriteString("\n" + request.Body + "\n")
+		}
+	}
+	return b.String()
+}
+
+func firstLine(text, fallback string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return fallback
+	}
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
@@ -0,0 +1,85 @@
+package exporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestHTTPFile(t *testing.T) {
+	service := petService()
+	got := HTTPFile(service.APIDocs, service.Auth)
+
+	for _, want := range []string{
+		"@baseUrl = https://api.example.com/v1\n",
+		"@token = <your token>\n",
+		"### Get a pet\nGET {{baseUrl}}/pets/7?fields={{fields}}\nAuthorization: Bearer {{token}}\n",
+		"### Create a pet\nPOST {{baseUrl}}/pets\nAuthorization: Bearer {{token}}\nContent-Type: application/json\n",
+		"{\n  \"name\": \"Rex\",\n  \"age\": 3\n}",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("HTTPFile() missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestHTTPFile_APIKey(t *testing.T) {
+	service := petService()
+	service.Auth = &models.Auth{Method: "api-key", KeyName: "X-Pets-Key", KeyIn: "header"}
+	got := HTTPFile(service.APIDocs, service.Auth)
+	if !strings.Contains(got, "X-Pets-Key: {{apiKey}}") {
+		t.Errorf("HTTPFile() missing api key header in:\n%s", got)
+	}
+
+	// a key sent in the query becomes a query parameter
+	service.Auth.KeyName, service.Auth.KeyIn = "key", "query"
+	got = HTTPFile(service.APIDocs, service.Auth)
+	if !strings.Contains(got, "@apiKey = ") || !strings.Contains(got, "/pets?key={{apiKey}}") || strings.Contains(got, "X-Pets-Key") {
+		t.Errorf("HTTPFile() missing api key query parameter in:\n%s", got)
+	}
+}
+
+func TestParameterIn(t *testing.T) {
+	endpoints := petService().APIDocs.Endpoints
+
+	tests := []struct {
+		name  string
+		index int
+		param int
+		want  string
+	}{
+		{"path", 0, 0, "path"},
+		{"query", 0, 1, "query"},
+		{"body", 1, 0, "body"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := endpoints[tt.index]
+			if got := ParameterIn(endpoint, endpoint.Parameters[tt.param]); got != tt.want {
+				t.Errorf("ParameterIn() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// an explicit location wins over the inferred one
+	endpoint := endpoints[1]
+	endpoint.Parameters[1].In = "header"
+	if got := ParameterIn(endpoint, endpoint.Parameters[1]); got != "header" {
+		t.Errorf("ParameterIn() = %q, want the explicit header location", got)
+	}
+}
+
+func TestHTTPFile_RequestBody(t *testing.T) {
+	service := petService()
+	service.APIDocs.Endpoints = []models.Endpoint{createPetEndpoint()}
+	got := HTTPFile(service.APIDocs, nil)
+
+	want := "POST {{baseUrl}}/owners/{{ownerId}}/pets\n" +
+		"X-Request-ID: abc-123\n" +
+		"Content-Type: application/json\n\n" +
+		createPetEndpoint().RequestBody + "\n"
+	if !strings.Contains(got, want) {
+		t.Errorf("HTTPFile() missing %q in:\n%s", want, got)
+	}
+}
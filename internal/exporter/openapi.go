@@ -0,0 +1,450 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPIVersion is the version of the exported documents
+const OpenAPIVersion = "3.1.0"
+
+type openAPIDocument struct {
+	OpenAPI    string                           `yaml:"openapi"`
+	Info       openAPIInfo                      `yaml:"info"`
+	Servers    []openAPIServer                  `yaml:"servers,omitempty"`
+	Security   []map[string][]string            `yaml:"security,omitempty"`
+	Paths      map[string]map[string]*operation `yaml:"paths"`
+	Components *components                      `yaml:"components,omitempty"`
+}
+
+type openAPIInfo struct {
+	Title       string          `yaml:"title"`
+	Description string          `yaml:"description,omitempty"`
+	Version     string          `yaml:"version"`
+	Contact     *openAPIContact `yaml:"contact,omitempty"`
+	License     *openAPILicense `yaml:"license,omitempty"`
+}
+
+type openAPIContact struct {
+	Name  string `yaml:"name,omitempty"`
+	Email string `yaml:"email,omitempty"`
+	URL   string `yaml:"url,omitempty"`
+}
+
+type openAPILicense struct {
+	Name       string `yaml:"name"`
+	Identifier string `yaml:"identifier"`
+}
+
+type openAPIServer struct {
+	URL string `yaml:"url"`
+}
+
+type operation struct {
+	Summary     string               `yaml:"summary,omitempty"`
+	OperationID string               `yaml:"operationId"`
+	Parameters  []parameter          `yaml:"parameters,omitempty"`
+	RequestBody *requestBody         `yaml:"requestBody,omitempty"`
+	Responses   map[string]*response `yaml:"responses"`
+}
+
+type parameter struct {
+	Name        string      `yaml:"name"`
+	In          string      `yaml:"in"`
+	Description string      `yaml:"description,omitempty"`
+	Required    bool        `yaml:"required,omitempty"`
+	Schema      *schema     `yaml:"schema"`
+	Example     interface{} `yaml:"example,omitempty"`
+}
+
+type requestBody struct {
+	Required bool                  `yaml:"required,omitempty"`
+	Content  map[string]*mediaType `yaml:"content"`
+}
+
+type response struct {
+	Ref         string                `yaml:"$ref,omitempty"`
+	Description string                `yaml:"description,omitempty"`
+	Content     map[string]*mediaType `yaml:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema  *schema     `yaml:"schema,omitempty"`
+	Example interface{} `yaml:"example,omitempty"`
+}
+
+type schema struct {
+	Ref         string             `yaml:"$ref,omitempty"`
+	Type        string             `yaml:"type,omitempty"`
+	Format      string             `yaml:"format,omitempty"`
+	Description string             `yaml:"description,omitempty"`
+	Enum        []interface{}      `yaml:"enum,omitempty"`
+	Minimum     *float64           `yaml:"minimum,omitempty"`
+	Maximum     *float64           `yaml:"maximum,omitempty"`
+	MinLength   *float64           `yaml:"minLength,omitempty"`
+	MaxLength   *float64           `yaml:"maxLength,omitempty"`
+	MinItems    *float64           `yaml:"minItems,omitempty"`
+	MaxItems    *float64           `yaml:"maxItems,omitempty"`
+	Pattern     string             `yaml:"pattern,omitempty"`
+	Items       *schema            `yaml:"items,omitempty"`
+	Properties  map[string]*schema `yaml:"properties,omitempty"`
+	Required    []string           `yaml:"required,omitempty"`
+	Examples    []interface{}      `yaml:"examples,omitempty"`
+}
+
+type components struct {
+	Schemas         map[string]*schema         `yaml:"schemas,omitempty"`
+	Responses       map[string]*response       `yaml:"responses,omitempty"`
+	SecuritySchemes map[string]*securityScheme `yaml:"securitySchemes,omitempty"`
+}
+
+type securityScheme struct {
+	Type         string               `yaml:"type"`
+	Description  string               `yaml:"description,omitempty"`
+	Scheme       string               `yaml:"scheme,omitempty"`
+	BearerFormat string               `yaml:"bearerFormat,omitempty"`
+	Name         string               `yaml:"name,omitempty"`
+	In           string               `yaml:"in,omitempty"`
+	Flows        map[string]*authFlow `yaml:"flows,omitempty"`
+}
+
+type authFlow struct {
+	AuthorizationURL string            `yaml:"authorizationUrl,omitempty"`
+	TokenURL         string            `yaml:"tokenUrl,omitempty"`
+	Scopes           map[string]string `yaml:"scopes"`
+}
+
+// OpenAPI renders an api-service project as an OpenAPI 3.1 YAML document.
+// Parameters are placed with ParameterIn, error handling becomes shared
+// responses and an Error schema, and auth becomes the security scheme.
+func OpenAPI(service *models.APIService) ([]byte, error) {
+	doc := openAPIDocument{
+		OpenAPI: OpenAPIVersion,
+		Info: openAPIInfo{
+			Title:       service.Title,
+			Description: service.Description,
+			Version:     "1.0.0",
+		},
+		Paths: map[string]map[string]*operation{},
+	}
+	if author := service.Author; author != (models.AuthorInfo{}) {
+		doc.Info.Contact = &openAPIContact{Name: author.Name, Email: author.Email, URL: firstNonEmpty(author.Website, author.GitHub)}
+	}
+	if service.License != "" {
+		doc.Info.License = &openAPILicense{Name: service.License, Identifier: service.License}
+	}
+	if service.APIDocs.BaseURL != "" {
+		doc.Servers = []openAPIServer{{URL: service.APIDocs.BaseURL}}
+	}
+
+	for _, endpoint := range service.APIDocs.Endpoints {
+		item, ok := doc.Paths[endpoint.Path]
+		if !ok {
+			item = map[string]*operation{}
+			doc.Paths[endpoint.Path] = item
+		}
+		item[strings.ToLower(endpoint.Method)] = endpointOperation(endpoint)
+	}
+
+	doc.Components = errorComponents(service.APIDocs.ErrorHandling)
+	if doc.Components != nil {
+		// every operation can fail with the shared error responses
+		for _, item := range doc.Paths {
+			for _, op := range item {
+				for name := range doc.Components.Responses {
+					op.Responses[strings.TrimPrefix(name, "Status")] = &response{Ref: "#/components/responses/" + name}
+				}
+			}
+		}
+	}
+	if scheme := authScheme(service.Auth, service.APIDocs.BaseURL); scheme != nil {
+		if doc.Components == nil {
+			doc.Components = &components{}
+		}
+		doc.Components.SecuritySchemes = map[string]*securityScheme{"auth": scheme}
+		doc.Security = []map[string][]string{{"auth": {}}}
+	}
+
+	return yaml.Marshal(doc)
+}
+
+func endpointOperation(endpoint models.Endpoint) *operation {
+	op := &operation{
+		Summary:     endpoint.Description,
+		OperationID: operationID(endpoint),
+		Responses: map[string]*response{
+			"200": {Description: "Successful response"},
+		},
+	}
+
+	body := &schema{Type: "object", Properties: map[string]*schema{}}
+	for _, p := range endpoint.Parameters {
+		in := ParameterIn(endpoint, p)
+		if in == "body" {
+			property := parameterSchema(p)
+			property.Description, property.Examples = p.Description, exampleList(p)
+			body.Properties[p.Name] = property
+			if p.Required {
+				body.Required = append(body.Required, p.Name)
+			}
+			continue
+		}
+		op.Parameters = append(op.Parameters, parameter{
+			Name:        p.Name,
+			In:          in,
+			Description: p.Description,
+			Required:    p.Required || in == "path",
+			Schema:      parameterSchema(p),
+			Example:     exampleValue(p),
+		})
+	}
+	// every path template must be declared, described or not
+	for _, match := range pathParamPattern.FindAllStringSubmatch(endpoint.Path, -1) {
+		if !slices.ContainsFunc(endpoint.Parameters, func(p models.Parameter) bool { return p.Name == match[1] }) {
+			op.Parameters = append(op.Parameters, parameter{Name: match[1], In: "path", Required: true, Schema: &schema{Type: "string"}})
+		}
+	}
+
+	for _, header := range endpoint.Headers {
+		op.Parameters = append(op.Parameters, parameter{
+			Name:    header.Name,
+			In:      "header",
+			Schema:  &schema{Type: "string"},
+			Example: header.Value,
+		})
+	}
+
+	media := &mediaType{}
+	if len(body.Properties) > 0 {
+		media.Schema = body
+	}
+	if endpoint.RequestBody != "" {
+		media.Example = jsonOrString(endpoint.RequestBody)
+		if media.Schema == nil {
+			media.Schema = schemaFromExample(media.Example)
+		}
+	}
+	if media.Schema != nil {
+		op.RequestBody = &requestBody{
+			Required: len(body.Required) > 0 || endpoint.RequestBody != "",
+			Content:  map[string]*mediaType{"application/json": media},
+		}
+	}
+
+	if endpoint.Response != "" {
+		op.Responses["200"].Content = map[string]*mediaType{"application/json": {Example: jsonOrString(endpoint.Response)}}
+	}
+	return op
+}
+
+// errorComponents turns the documented error handling into shared
+// StatusNNN responses, whose content references an Error schema built from
+// the documented error structure
+func errorComponents(errorHandling *models.ErrorHandling) *components {
+	if errorHandling == nil {
+		return nil
+	}
+
+	c := &components{Responses: map[string]*response{}}
+	var content map[string]*mediaType
+	if structure := errorHandling.ErrorResponse.Structure; structure != "" {
+		c.Schemas = map[string]*schema{"Error": schemaFromExample(jsonOrString(structure))}
+		media := &mediaType{Schema: &schema{Ref: "#/components/schemas/Error"}}
+		if example := errorHandling.ErrorResponse.Example; example != "" {
+			media.Example = jsonOrString(example)
+		}
+		content = map[string]*mediaType{"application/json": media}
+	}
+
+	for _, statusCode := range errorHandling.StatusCodes {
+		if statusCode.Code >= 400 {
+			c.Responses[fmt.Sprintf("Status%d", statusCode.Code)] = &response{Description: statusCode.Description, Content: content}
+		}
+	}
+	for _, commonError := range errorHandling.CommonErrors {
+		name := fmt.Sprintf("Status%d", commonError.Code)
+		if _, ok := c.Responses[name]; !ok {
+			c.Responses[name] = &response{Description: commonError.Message, Content: content}
+		}
+	}
+	if len(c.Responses) == 0 && c.Schemas == nil {
+		return nil
+	}
+	return c
+}
+
+// schemaFromExample describes the top level of a JSON example
+func schemaFromExample(example interface{}) *schema {
+	switch value := example.(type) {
+	case map[string]interface{}:
+		s := &schema{Type: "object", Properties: map[string]*schema{}}
+		for name, property := range value {
+			s.Properties[name] = schemaFromExample(property)
+		}
+		return s
+	case []interface{}:
+		return &schema{Type: "array"}
+	case float64:
+		if value == math.Trunc(value) {
+			return &schema{Type: "integer"}
+		}
+		return &schema{Type: "number"}
+	case bool:
+		return &schema{Type: "boolean"}
+	default:
+		return &schema{Type: "string"}
+	}
+}
+
+func authScheme(auth *models.Auth, baseURL string) *securityScheme {
+	if auth == nil {
+		return nil
+	}
+	switch auth.Method {
+	case "jwt":
+		return &securityScheme{Type: "http", Scheme: "bearer", BearerFormat: "JWT", Description: auth.TokenFormat}
+	case "basic":
+		return &securityScheme{Type: "http", Scheme: "basic", Description: auth.TokenFormat}
+	case "api-key":
+		return &securityScheme{Type: "apiKey", Name: auth.KeyName, In: auth.KeyIn, Description: auth.TokenFormat}
+	case "oauth":
+		// the token URL is the documented auth endpoint; without one the
+		// flow is unknown, so only the bearer token is described
+		if len(auth.Endpoints) == 0 {
+			return &securityScheme{Type: "http", Scheme: "bearer", Description: auth.TokenFormat}
+		}
+		tokenURL := auth.Endpoints[0]
+		if !strings.Contains(tokenURL, "://") {
+			tokenURL = baseURL + tokenURL
+		}
+		return &securityScheme{
+			Type:        "oauth2",
+			Description: auth.TokenFormat,
+			Flows: map[string]*authFlow{
+				"clientCredentials": {TokenURL: tokenURL, Scopes: map[string]string{}},
+			},
+		}
+	}
+	return nil
+}
+
+// operationID derives a camelCase id such as getUsersId from GET /users/{id}
+func operationID(endpoint models.Endpoint) string {
+	id := strings.ToLower(endpoint.Method)
+	for _, part := range strings.FieldsFunc(endpoint.Path, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-' || r == '_' || r == '.'
+	}) {
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
+
+// parameterSchema describes a parameter's type and constraints. Min and
+// max bound the value of numbers and the length of strings and arrays.
+func parameterSchema(p models.Parameter) *schema {
+	s := &schema{Format: p.Format, Pattern: p.Pattern}
+	switch p.Type {
+	case "int":
+		s.Type = "integer"
+	case "number":
+		s.Type = "number"
+	case "bool":
+		s.Type = "boolean"
+	case "array":
+		s.Type, s.Items = "array", &schema{Type: "string"}
+	case "object":
+		s.Type = "object"
+	case "file":
+		s.Type, s.Format = "string", "binary"
+	case "date-time":
+		s.Type, s.Format = "string", "date-time"
+	default:
+		s.Type = "string"
+	}
+
+	switch s.Type {
+	case "integer", "number":
+		s.Minimum, s.Maximum = p.Min, p.Max
+	case "array":
+		s.MinItems, s.MaxItems = p.Min, p.Max
+	case "string":
+		s.MinLength, s.MaxLength = p.Min, p.Max
+	}
+
+	// enums of arrays restrict their items
+	enum, enumType := &s.Enum, p.Type
+	if s.Items != nil {
+		enum, enumType = &s.Items.Enum, "string"
+	}
+	for _, value := range p.Enum {
+		*enum = append(*enum, exampleValue(models.Parameter{Type: enumType, Example: value}))
+	}
+	return s
+}
+
+// exampleValue is a parameter's example in its own type, or nil
+func exampleValue(p models.Parameter) interface{} {
+	if p.Example == "" {
+		return nil
+	}
+	switch p.Type {
+	case "int":
+		if value, err := strconv.Atoi(p.Example); err == nil {
+			return value
+		}
+	case "number":
+		if value, err := strconv.ParseFloat(p.Example, 64); err == nil {
+			return value
+		}
+	case "bool":
+		if value, err := strconv.ParseBool(p.Example); err == nil {
+			return value
+		}
+	case "array":
+		if value, ok := jsonOrString(p.Example).([]interface{}); ok {
+			return value
+		}
+		var values []interface{}
+		for _, item := range strings.Split(p.Example, ",") {
+			values = append(values, strings.TrimSpace(item))
+		}
+		return values
+	case "object":
+		if value, ok := jsonOrString(p.Example).(map[string]interface{}); ok {
+			return value
+		}
+	}
+	return p.Example
+}
+
+func exampleList(p models.Parameter) []interface{} {
+	if value := exampleValue(p); value != nil {
+		return []interface{}{value}
+	}
+	return nil
+}
+
+// jsonOrString decodes JSON examples so they are embedded as structures
+func jsonOrString(text string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(text), &value); err == nil {
+		return value
+	}
+	return text
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,249 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+func petService() *models.APIService {
+	return &models.APIService{
+		BaseInfo: models.BaseInfo{
+			Title:       "Pets",
+			Description: "Pet store service",
+			License:     "MIT",
+			Author:      models.AuthorInfo{Name: "Jo Doe", Email: "jo@example.com"},
+		},
+		Auth: &models.Auth{Method: "jwt"},
+		APIDocs: models.APIDocs{
+			BaseURL: "https://api.example.com/v1",
+			Endpoints: []models.Endpoint{
+				{
+					Method:      "GET",
+					Path:        "/pets/{id}",
+					Description: "Get a pet",
+					Parameters: []models.Parameter{
+						{Name: "id", Type: "int", Required: true, Description: "Pet identifier", Example: "7"},
+						{Name: "fields", Type: "array", Enum: []string{"name", "tag"}, Description: "Fields to include"},
+					},
+					Response: `{"id": 7, "name": "Rex"}`,
+				},
+				{
+					Method:      "POST",
+					Path:        "/pets",
+					Description: "Create a pet",
+					Parameters: []models.Parameter{
+						{Name: "name", Type: "string", Required: true, Description: "Pet name", Example: "Rex"},
+						{Name: "age", Type: "int", Description: "Age in years", Example: "3"},
+					},
+					Response: `{"id": 8}`,
+				},
+			},
+		},
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	data, err := OpenAPI(petService())
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("output is not YAML: %v\n%s", err, data)
+	}
+
+	if doc["openapi"] != OpenAPIVersion {
+		t.Errorf("openapi = %v, want %s", doc["openapi"], OpenAPIVersion)
+	}
+
+	info := doc["info"].(map[string]interface{})
+	if info["title"] != "Pets" {
+		t.Errorf("info.title = %v, want Pets", info["title"])
+	}
+
+	servers := doc["servers"].([]interface{})
+	if url := servers[0].(map[string]interface{})["url"]; url != "https://api.example.com/v1" {
+		t.Errorf("servers[0].url = %v", url)
+	}
+
+	paths := doc["paths"].(map[string]interface{})
+	get, ok := paths["/pets/{id}"].(map[string]interface{})["get"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("missing GET /pets/{id} in %v", paths)
+	}
+	params := get["parameters"].([]interface{})
+	if len(params) != 2 {
+		t.Fatalf("got %d parameters, want 2", len(params))
+	}
+	id := params[0].(map[string]interface{})
+	if id["in"] != "path" || id["required"] != true {
+		t.Errorf("id parameter = %v, want a required path parameter", id)
+	}
+	fields := params[1].(map[string]interface{})
+	if fields["in"] != "query" {
+		t.Errorf("fields parameter in = %v, want query", fields["in"])
+	}
+	items := fields["schema"].(map[string]interface{})["items"].(map[string]interface{})
+	if enum := items["enum"].([]interface{}); len(enum) != 2 || enum[0] != "name" {
+		t.Errorf("fields items = %v, want the enum on the array items", items)
+	}
+
+	post := paths["/pets"].(map[string]interface{})["post"].(map[string]interface{})
+	if post["parameters"] != nil {
+		t.Errorf("POST parameters = %v, want them in the request body", post["parameters"])
+	}
+	body := post["requestBody"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"].(map[string]interface{})
+	if required := body["required"].([]interface{}); len(required) != 1 || required[0] != "name" {
+		t.Errorf("body required = %v, want [name]", required)
+	}
+
+	service := petService()
+	service.APIDocs.Endpoints = []models.Endpoint{createPetEndpoint()}
+	data, err = OpenAPI(service)
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+	var created map[string]interface{}
+	if err := yaml.Unmarshal(data, &created); err != nil {
+		t.Fatalf("output is not YAML: %v", err)
+	}
+	op := created["paths"].(map[string]interface{})["/owners/{ownerId}/pets"].(map[string]interface{})["post"].(map[string]interface{})
+	if header := op["parameters"].([]interface{})[1].(map[string]interface{}); header["in"] != "header" || header["example"] != "abc-123" {
+		t.Errorf("header parameter = %v, want X-Request-ID in header", header)
+	}
+	media := op["requestBody"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})
+	if example := media["example"].(map[string]interface{}); example["name"] != "Rex" {
+		t.Errorf("request body example = %v, want the endpoint's request body", example)
+	}
+	if media["schema"].(map[string]interface{})["type"] != "object" {
+		t.Errorf("request body schema = %v, want one built from the example", media["schema"])
+	}
+
+	security := doc["security"].([]interface{})
+	if _, ok := security[0].(map[string]interface{})["auth"]; !ok {
+		t.Errorf("security = %v, want the auth scheme", security)
+	}
+	schemes := doc["components"].(map[string]interface{})["securitySchemes"].(map[string]interface{})
+	if scheme := schemes["auth"].(map[string]interface{}); scheme["scheme"] != "bearer" {
+		t.Errorf("auth scheme = %v, want bearer", scheme)
+	}
+}
+
+func TestOpenAPI_APIKey(t *testing.T) {
+	service := petService()
+	service.Auth = &models.Auth{Method: "api-key", KeyName: "api_key", KeyIn: "query"}
+
+	data, err := OpenAPI(service)
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("output is not YAML: %v", err)
+	}
+	schemes := doc["components"].(map[string]interface{})["securitySchemes"].(map[string]interface{})
+	if scheme := schemes["auth"].(map[string]interface{}); scheme["name"] != "api_key" || scheme["in"] != "query" {
+		t.Errorf("auth scheme = %v, want the documented api_key query parameter", scheme)
+	}
+}
+
+func TestOpenAPI_OAuth(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []string
+		want      string // the token URL, or empty for a bearer scheme
+	}{
+		{"documented endpoint", []string{"/oauth2/token"}, "https://api.example.com/v1/oauth2/token"},
+		{"absolute endpoint", []string{"https://auth.example.com/token"}, "https://auth.example.com/token"},
+		{"no endpoint", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := petService()
+			service.Auth = &models.Auth{Method: "oauth", Endpoints: tt.endpoints}
+
+			data, err := OpenAPI(service)
+			if err != nil {
+				t.Fatalf("OpenAPI() error = %v", err)
+			}
+			var doc map[string]interface{}
+			if err := yaml.Unmarshal(data, &doc); err != nil {
+				t.Fatalf("output is not YAML: %v", err)
+			}
+			scheme := doc["components"].(map[string]interface{})["securitySchemes"].(map[string]interface{})["auth"].(map[string]interface{})
+			if tt.want == "" {
+				if scheme["type"] != "http" || scheme["scheme"] != "bearer" {
+					t.Errorf("auth scheme = %v, want bearer", scheme)
+				}
+				return
+			}
+			flow, _ := scheme["flows"].(map[string]interface{})["clientCredentials"].(map[string]interface{})
+			if scheme["type"] != "oauth2" || flow["tokenUrl"] != tt.want {
+				t.Errorf("auth scheme = %v, want token URL %s", scheme, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenAPI_ErrorResponses(t *testing.T) {
+	service := petService()
+	service.APIDocs.ErrorHandling = &models.ErrorHandling{
+		Format:        "json",
+		StatusCodes:   []models.StatusCode{{Code: 200, Description: "Success"}, {Code: 404, Description: "Pet not found"}},
+		ErrorResponse: models.ErrorResponse{Structure: `{"error": "string", "code": 0}`, Example: `{"error": "not found", "code": 404}`},
+		CommonErrors:  []models.CommonError{{Code: 500, Message: "Internal error"}},
+	}
+
+	data, err := OpenAPI(service)
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("output is not YAML: %v", err)
+	}
+
+	components := doc["components"].(map[string]interface{})
+	if _, ok := components["schemas"].(map[string]interface{})["Error"]; !ok {
+		t.Errorf("components = %v, want an Error schema", components)
+	}
+	notFound := components["responses"].(map[string]interface{})["Status404"].(map[string]interface{})
+	schema := notFound["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"]
+	if ref := schema.(map[string]interface{})["$ref"]; ref != "#/components/schemas/Error" {
+		t.Errorf("Status404 schema = %v, want a reference to the Error schema", schema)
+	}
+
+	// every operation references the shared error responses
+	for path, item := range doc["paths"].(map[string]interface{}) {
+		for method, op := range item.(map[string]interface{}) {
+			responses := op.(map[string]interface{})["responses"].(map[string]interface{})
+			for code, name := range map[string]string{"404": "Status404", "500": "Status500"} {
+				response, _ := responses[code].(map[string]interface{})
+				if response["$ref"] != "#/components/responses/"+name {
+					t.Errorf("%s %s response %s = %v, want a reference to %s", method, path, code, response, name)
+				}
+			}
+		}
+	}
+}
+
+func TestOpenAPI_NoAuth(t *testing.T) {
+	service := petService()
+	service.Auth = nil
+
+	data, err := OpenAPI(service)
+	if err != nil {
+		t.Fatalf("OpenAPI() error = %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("output is not YAML: %v", err)
+	}
+	if doc["security"] != nil {
+		t.Errorf("security = %v, want none without auth", doc["security"])
+	}
+}
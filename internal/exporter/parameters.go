@@ -0,0 +1,101 @@
+package exporter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// parameterLocations lists where parameters are sent, in display order
+var parameterLocations = []struct {
+	in    string
+	title string
+}{
+	{"path", "Path"},
+	{"query", "Query"},
+	{"header", "Header"},
+	{"body", "Body"},
+}
+
+// ParameterGroup holds the parameters of an endpoint sent in one location
+type ParameterGroup struct {
+	In         string // path, query, header or body
+	Title      string // In for headings, e.g. "Query"
+	Parameters []models.Parameter
+}
+
+// GroupParameters groups an endpoint's parameters by ParameterIn, leaving
+// out empty locations. Path parameters are always required, as in OpenAPI.
+func GroupParameters(endpoint models.Endpoint) []ParameterGroup {
+	var groups []ParameterGroup
+	for _, location := range parameterLocations {
+		group := ParameterGroup{In: location.in, Title: location.title}
+		for _, parameter := range endpoint.Parameters {
+			if ParameterIn(endpoint, parameter) == location.in {
+				parameter.Required = parameter.Required || location.in == "path"
+				group.Parameters = append(group.Parameters, parameter)
+			}
+		}
+		if len(group.Parameters) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// ParameterConstraints summarizes a parameter's format, enum, range and
+// pattern for a Markdown table cell, e.g. "one of `asc`, `desc`"
+func ParameterConstraints(parameter models.Parameter) string {
+	var constraints []string
+	if parameter.Format != "" {
+		constraints = append(constraints, "format `"+parameter.Format+"`")
+	}
+	if len(parameter.Enum) > 0 {
+		values := make([]string, len(parameter.Enum))
+		for i, value := range parameter.Enum {
+			values[i] = "`" + value + "`"
+		}
+		constraints = append(constraints, "one of "+strings.Join(values, ", "))
+	}
+	if bounds := parameterRange(parameter); bounds != "" {
+		constraints = append(constraints, bounds)
+	}
+	if parameter.Pattern != "" {
+		constraints = append(constraints, "matches `"+parameter.Pattern+"`")
+	}
+
+	// pipes would end the table cell, even inside code spans
+	return strings.ReplaceAll(strings.Join(constraints, "; "), "|", `\|`)
+}
+
+// parameterRange describes min and max, which bound the value of numbers
+// and the length of strings and arrays
+func parameterRange(parameter models.Parameter) string {
+	if parameter.Min == nil && parameter.Max == nil {
+		return ""
+	}
+
+	var prefix, suffix string
+	switch parameter.Type {
+	case "int", "number":
+	case "array":
+		suffix = " items"
+	default:
+		prefix = "length "
+	}
+
+	switch {
+	case parameter.Max == nil:
+		return fmt.Sprintf("%s≥ %s%s", prefix, formatNumber(*parameter.Min), suffix)
+	case parameter.Min == nil:
+		return fmt.Sprintf("%s≤ %s%s", prefix, formatNumber(*parameter.Max), suffix)
+	default:
+		return fmt.Sprintf("%s%s to %s%s", prefix, formatNumber(*parameter.Min), formatNumber(*parameter.Max), suffix)
+	}
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,77 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestGroupParameters(t *testing.T) {
+	endpoint := models.Endpoint{
+		Method: "PUT",
+		Path:   "/pets/{id}",
+		Parameters: []models.Parameter{
+			{Name: "name", Type: "string"},
+			{Name: "X-Trace", In: "header", Type: "string"},
+			{Name: "id", Type: "int"},
+			{Name: "dryRun", In: "query", Type: "bool"},
+		},
+	}
+
+	groups := GroupParameters(endpoint)
+	want := []struct {
+		in    string
+		names []string
+	}{
+		{"path", []string{"id"}},
+		{"query", []string{"dryRun"}},
+		{"header", []string{"X-Trace"}},
+		{"body", []string{"name"}},
+	}
+	if len(groups) != len(want) {
+		t.Fatalf("got %d groups, want %d: %+v", len(groups), len(want), groups)
+	}
+	for i, group := range groups {
+		if group.In != want[i].in || len(group.Parameters) != len(want[i].names) || group.Parameters[0].Name != want[i].names[0] {
+			t.Errorf("group %d = %+v, want %s %v", i, group, want[i].in, want[i].names)
+		}
+	}
+
+	// path parameters are required even when not marked so
+	if !groups[0].Parameters[0].Required || groups[1].Parameters[0].Required {
+		t.Errorf("only the path parameter should be required, got %+v and %+v", groups[0].Parameters[0], groups[1].Parameters[0])
+	}
+	if endpoint.Parameters[2].Required {
+		t.Error("GroupParameters changed the endpoint's parameters")
+	}
+
+	if groups := GroupParameters(models.Endpoint{Method: "GET", Path: "/pets"}); len(groups) != 0 {
+		t.Errorf("expected no groups without parameters, got %+v", groups)
+	}
+}
+
+func TestParameterConstraints(t *testing.T) {
+	one, hundred := 1.0, 100.0
+
+	tests := []struct {
+		name      string
+		parameter models.Parameter
+		want      string
+	}{
+		{"none", models.Parameter{Type: "string"}, ""},
+		{"format", models.Parameter{Type: "string", Format: "uuid"}, "format `uuid`"},
+		{"enum", models.Parameter{Type: "string", Enum: []string{"asc", "desc"}}, "one of `asc`, `desc`"},
+		{"value range", models.Parameter{Type: "int", Min: &one, Max: &hundred}, "1 to 100"},
+		{"min length", models.Parameter{Type: "string", Min: &one}, "length ≥ 1"},
+		{"max items", models.Parameter{Type: "array", Max: &hundred}, "≤ 100 items"},
+		{"pattern", models.Parameter{Type: "string", Pattern: "^a|b$"}, "matches `^a\\|b$`"},
+		{"combined", models.Parameter{Type: "number", Format: "double", Min: &one}, "format `double`; ≥ 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParameterConstraints(tt.parameter); got != tt.want {
+				t.Errorf("ParameterConstraints() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
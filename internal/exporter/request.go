@@ -0,0 +1,174 @@
+package exporter
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// pathParamPattern matches {name} path parameters
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// Request is an example call of an endpoint, built from its parameter
+// examples. Values without an example are left as {{name}} placeholders.
+type Request struct {
+	Method  string
+	URL     string
+	Headers []models.Header
+	Body    string // JSON, empty for requests without a body
+}
+
+// ParameterIn tells where a parameter is sent: its own location when set,
+// otherwise "path" when the path names it, "query" for methods without a
+// body and "body" for the rest
+func ParameterIn(endpoint models.Endpoint, parameter models.Parameter) string {
+	if parameter.In != "" {
+		return parameter.In
+	}
+	if strings.Contains(endpoint.Path, "{"+parameter.Name+"}") {
+		return "path"
+	}
+	if hasBody(endpoint.Method) {
+		return "body"
+	}
+	return "query"
+}
+
+// BuildRequest builds the example request of an endpoint against baseURL.
+// The endpoint's request body example, when set, replaces the body built
+// from its parameters.
+func BuildRequest(baseURL string, auth *models.Auth, endpoint models.Endpoint) Request {
+	path := endpoint.Path
+	query := url.Values{}
+	body := map[string]interface{}{}
+	var (
+		bodyKeys []string
+		headers  []models.Header
+	)
+
+	for _, parameter := range endpoint.Parameters {
+		switch ParameterIn(endpoint, parameter) {
+		case "path":
+			path = strings.ReplaceAll(path, "{"+parameter.Name+"}", exampleOrPlaceholder(parameter))
+		case "query":
+			query.Set(parameter.Name, exampleOrPlaceholder(parameter))
+		case "header":
+			headers = append(headers, models.Header{Name: parameter.Name, Value: exampleOrPlaceholder(parameter)})
+		case "body":
+			body[parameter.Name] = typedExample(parameter)
+			bodyKeys = append(bodyKeys, parameter.Name)
+		}
+	}
+
+	if name, ok := AuthQuery(auth); ok {
+		query.Set(name, "{{apiKey}}")
+	}
+
+	// path parameters that weren't described still need a value
+	path = pathParamPattern.ReplaceAllString(path, "{{$1}}")
+
+	request := Request{
+		Method: endpoint.Method,
+		URL:    strings.TrimSuffix(baseURL, "/") + path,
+	}
+	if len(query) > 0 {
+		// keep placeholders readable rather than percent-encoded
+		request.URL += "?" + strings.NewReplacer("%7B", "{", "%7D", "}").Replace(query.Encode())
+	}
+	if header, _, ok := AuthHeader(auth); ok {
+		request.Headers = append(request.Headers, header)
+	}
+	request.Headers = append(request.Headers, headers...)
+	request.Headers = append(request.Headers, endpoint.Headers...)
+
+	switch {
+	case strings.TrimSpace(endpoint.RequestBody) != "":
+		request.Body = strings.TrimSpace(endpoint.RequestBody)
+	case len(bodyKeys) > 0:
+		request.Body = orderedJSON(bodyKeys, body)
+	}
+	if request.Body != "" && !hasHeader(request.Headers, "Content-Type") {
+		request.Headers = append(request.Headers, models.Header{Name: "Content-Type", Value: "application/json"})
+	}
+	return request
+}
+
+// AuthHeader is the header an authenticated request sends, with the
+// credential left as a {{credential}} placeholder. An API key sent in the
+// query has a credential but no header; see AuthQuery.
+func AuthHeader(auth *models.Auth) (header models.Header, credential string, ok bool) {
+	if auth == nil {
+		return models.Header{}, "", false
+	}
+	switch auth.Method {
+	case "jwt", "oauth":
+		return models.Header{Name: "Authorization", Value: "Bearer {{token}}"}, "token", true
+	case "basic":
+		return models.Header{Name: "Authorization", Value: "Basic {{credentials}}"}, "credentials", true
+	case "api-key":
+		switch auth.KeyIn {
+		case "header":
+			return models.Header{Name: auth.KeyName, Value: "{{apiKey}}"}, "apiKey", true
+		case "cookie":
+			return models.Header{Name: "Cookie", Value: auth.KeyName + "={{apiKey}}"}, "apiKey", true
+		}
+		return models.Header{}, "apiKey", false
+	}
+	return models.Header{}, "", false
+}
+
+// AuthQuery is the query parameter carrying an API key sent in the query
+func AuthQuery(auth *models.Auth) (name string, ok bool) {
+	if auth == nil || auth.Method != "api-key" || auth.KeyIn != "query" {
+		return "", false
+	}
+	return auth.KeyName, true
+}
+
+func hasHeader(headers []models.Header, name string) bool {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasBody(method string) bool {
+	return method == "POST" || method == "PUT" || method == "PATCH"
+}
+
+func exampleOrPlaceholder(parameter models.Parameter) string {
+	if parameter.Example != "" {
+		return parameter.Example
+	}
+	return "{{" + parameter.Name + "}}"
+}
+
+// typedExample keeps numbers and booleans typed in JSON bodies
+func typedExample(parameter models.Parameter) interface{} {
+	if value := exampleValue(parameter); value != nil {
+		return value
+	}
+	return exampleOrPlaceholder(parameter)
+}
+
+// orderedJSON renders an object with its keys in parameter order
+func orderedJSON(keys []string, values map[string]interface{}) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	for i, key := range keys {
+		name, _ := json.Marshal(key)
+		value, _ := json.Marshal(values[key])
+		b.WriteString("  " + string(name) + ": " + string(value))
+		if i < len(keys)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
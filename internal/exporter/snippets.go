@@ -0,0 +1,340 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// placeholderPattern matches the {{name}} placeholders of a Request
+var placeholderPattern = regexp.MustCompile(`\{\{([\w.-]+)\}\}`)
+
+// Snippet is an example call of an endpoint in one language
+type Snippet struct {
+	Label    string // shown on the tab or summary, e.g. "Python"
+	Language string // code fence language
+	Code     string
+}
+
+// Snippets renders the example request of an endpoint as a curl command
+// and Go net/http, Python requests and JavaScript fetch code. Values
+// without an example are written as <name>.
+func Snippets(baseURL string, auth *models.Auth, endpoint models.Endpoint) []Snippet {
+	request := BuildRequest(baseURL, auth, endpoint)
+	request.URL = fillPlaceholders(request.URL)
+	request.Body = fillPlaceholders(request.Body)
+	headers := make([]models.Header, len(request.Headers))
+	for i, header := range request.Headers {
+		headers[i] = models.Header{Name: header.Name, Value: fillPlaceholders(header.Value)}
+	}
+	request.Headers = headers
+
+	_, isText := jsonOrString(endpoint.Response).(string)
+	return []Snippet{
+		{Label: "curl", Language: "bash", Code: CurlSnippet(request)},
+		{Label: "Go", Language: "go", Code: GoSnippet(request)},
+		{Label: "Python", Language: "python", Code: PythonSnippet(request, !isText)},
+		{Label: "JavaScript", Language: "javascript", Code: JavaScriptSnippet(request, !isText)},
+	}
+}
+
+// CurlSnippet renders a request as a curl command
+func CurlSnippet(request Request) string {
+	var b strings.Builder
+	switch request.Method {
+	case "GET":
+		b.WriteString("curl")
+	case "HEAD":
+		b.WriteString("curl --head")
+	default:
+		b.WriteString("curl -X " + request.Method)
+	}
+	b.WriteString(" " + shellQuote(request.URL))
+	for _, header := range request.Headers {
+		b.WriteString(" \\\n  -H " + shellQuote(header.Name+": "+header.Value))
+	}
+	if request.Body != "" {
+		b.WriteString(" \\\n  -d " + shellQuote(request.Body))
+	}
+	return b.String()
+}
+
+// GoSnippet renders a request with net/http
+func GoSnippet(request Request) string {
+	body := "nil"
+	if request.Body != "" {
+		body = "strings.NewReader(" + goString(request.Body) + ")"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "req, err := http.NewRequest(%s, %s, %s)\n", goMethod(request.Method), strconv.Quote(request.URL), body)
+	b.WriteString("if err != nil {\n\tlog.Fatal(err)\n}\n")
+	for _, header := range request.Headers {
+		fmt.Fprintf(&b, "req.Header.Set(%s, %s)\n", strconv.Quote(header.Name), strconv.Quote(header.Value))
+	}
+	b.WriteString("\nresp, err := http.DefaultClient.Do(req)\n")
+	b.WriteString("if err != nil {\n\tlog.Fatal(err)\n}\n")
+	b.WriteString("defer resp.Body.Close()\n\n")
+	b.WriteString("body, err := io.ReadAll(resp.Body)\n")
+	b.WriteString("if err != nil {\n\tlog.Fatal(err)\n}\n")
+	b.WriteString("fmt.Println(resp.Status, string(body))")
+	return b.String()
+}
+
+// PythonSnippet renders a request with the requests library, sending JSON
+// bodies through json= so requests sets the content type
+func PythonSnippet(request Request, jsonResponse bool) string {
+	body, isJSON := decodeOrdered(request.Body)
+
+	var args []string
+	args = append(args, quote(request.URL))
+
+	var headers []string
+	for _, header := range request.Headers {
+		if isJSON && strings.EqualFold(header.Name, "Content-Type") {
+			continue
+		}
+		headers = append(headers, "        "+quote(header.Name)+": "+quote(header.Value)+",\n")
+	}
+	if len(headers) > 0 {
+		args = append(args, "headers={\n"+strings.Join(headers, "")+"    }")
+	}
+	switch {
+	case isJSON:
+		args = append(args, "json="+literal(body, "    ", "    ", true))
+	case request.Body != "":
+		args = append(args, "data="+quote(request.Body))
+	}
+
+	var b strings.Builder
+	b.WriteString("import requests\n\n")
+	call := "requests." + strings.ToLower(request.Method)
+	if len(args) == 1 {
+		fmt.Fprintf(&b, "response = %s(%s)\n", call, args[0])
+	} else {
+		fmt.Fprintf(&b, "response = %s(\n", call)
+		for _, arg := range args {
+			b.WriteString("    " + arg + ",\n")
+		}
+		b.WriteString(")\n")
+	}
+	if jsonResponse {
+		b.WriteString("print(response.json())")
+	} else {
+		b.WriteString("print(response.text)")
+	}
+	return b.String()
+}
+
+// JavaScriptSnippet renders a request with fetch
+func JavaScriptSnippet(request Request, jsonResponse bool) string {
+	var options []string
+	if request.Method != "GET" {
+		options = append(options, "method: "+quote(request.Method))
+	}
+	if len(request.Headers) > 0 {
+		var headers strings.Builder
+		headers.WriteString("headers: {\n")
+		for _, header := range request.Headers {
+			headers.WriteString("    " + quote(header.Name) + ": " + quote(header.Value) + ",\n")
+		}
+		headers.WriteString("  }")
+		options = append(options, headers.String())
+	}
+	if body, isJSON := decodeOrdered(request.Body); isJSON {
+		options = append(options, "body: JSON.stringify("+literal(body, "  ", "  ", false)+")")
+	} else if request.Body != "" {
+		options = append(options, "body: "+quote(request.Body))
+	}
+
+	var b strings.Builder
+	if len(options) == 0 {
+		fmt.Fprintf(&b, "const response = await fetch(%s);\n", quote(request.URL))
+	} else {
+		fmt.Fprintf(&b, "const response = await fetch(%s, {\n", quote(request.URL))
+		for _, option := range options {
+			b.WriteString("  " + option + ",\n")
+		}
+		b.WriteString("});\n")
+	}
+	if jsonResponse {
+		b.WriteString("console.log(await response.json());")
+	} else {
+		b.WriteString("console.log(await response.text());")
+	}
+	return b.String()
+}
+
+// fillPlaceholders turns {{name}} placeholders into <name>
+func fillPlaceholders(text string) string {
+	return placeholderPattern.ReplaceAllString(text, "<$1>")
+}
+
+func shellQuote(text string) string {
+	return "'" + strings.ReplaceAll(text, "'", `'\''`) + "'"
+}
+
+// goString prefers a raw string literal so JSON bodies stay readable
+func goString(text string) string {
+	if strings.Contains(text, "`") {
+		return strconv.Quote(text)
+	}
+	return "`" + text + "`"
+}
+
+func goMethod(method string) string {
+	if method == "" {
+		return strconv.Quote(method)
+	}
+	return "http.Method" + method[:1] + strings.ToLower(method[1:])
+}
+
+// quote renders a string literal that Python and JavaScript both accept
+func quote(text string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(text); err != nil {
+		return strconv.Quote(text)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// orderedObject is a JSON object that keeps its keys in order
+type orderedObject struct {
+	keys   []string
+	values []interface{}
+}
+
+// MarshalJSON writes the object with its keys in order
+func (o *orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeOrdered decodes a JSON document keeping object keys in order
+func decodeOrdered(text string) (interface{}, bool) {
+	if strings.TrimSpace(text) == "" {
+		return nil, false
+	}
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	value, err := decodeValue(decoder)
+	if err != nil || decoder.More() {
+		return nil, false
+	}
+	return value, true
+}
+
+func decodeValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		object := &orderedObject{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object.keys = append(object.keys, key.(string))
+			object.values = append(object.values, value)
+		}
+		_, err = decoder.Token()
+		return object, err
+	case '[':
+		var list []interface{}
+		for decoder.More() {
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err = decoder.Token()
+		return list, err
+	}
+	return nil, fmt.Errorf("unexpected %v", delim)
+}
+
+// literal renders a decoded JSON value as a Python or JavaScript literal
+// starting at the given indent
+func literal(value interface{}, indent, step string, python bool) string {
+	switch value := value.(type) {
+	case *orderedObject:
+		if len(value.keys) == 0 {
+			return "{}"
+		}
+		var b strings.Builder
+		b.WriteString("{\n")
+		for i, key := range value.keys {
+			b.WriteString(indent + step + quote(key) + ": " + literal(value.values[i], indent+step, step, python) + ",\n")
+		}
+		b.WriteString(indent + "}")
+		return b.String()
+	case []interface{}:
+		if len(value) == 0 {
+			return "[]"
+		}
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, item := range value {
+			b.WriteString(indent + step + literal(item, indent+step, step, python) + ",\n")
+		}
+		b.WriteString(indent + "]")
+		return b.String()
+	case string:
+		return quote(value)
+	case json.Number:
+		return value.String()
+	case bool:
+		switch {
+		case !python:
+			return strconv.FormatBool(value)
+		case value:
+			return "True"
+		default:
+			return "False"
+		}
+	case nil:
+		if python {
+			return "None"
+		}
+		return "null"
+	}
+	return fmt.Sprint(value)
+}
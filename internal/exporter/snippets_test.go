@@ -0,0 +1,108 @@
+package exporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func createPetEndpoint() models.Endpoint {
+	return models.Endpoint{
+		Method:      "POST",
+		Path:        "/owners/{ownerId}/pets",
+		Description: "Create a pet",
+		Headers:     []models.Header{{Name: "X-Request-ID", Value: "abc-123"}},
+		RequestBody: `{"name": "Rex", "vaccinated": true, "owner": null, "tags": ["good"]}`,
+		Response:    `{"id": 8}`,
+	}
+}
+
+func TestSnippets(t *testing.T) {
+	snippets := Snippets("https://api.example.com/v1/", &models.Auth{Method: "jwt"}, createPetEndpoint())
+
+	var labels []string
+	for _, snippet := range snippets {
+		labels = append(labels, snippet.Label)
+	}
+	if strings.Join(labels, ",") != "curl,Go,Python,JavaScript" {
+		t.Fatalf("unexpected snippets %v", labels)
+	}
+
+	for _, snippet := range snippets {
+		if !strings.Contains(snippet.Code, "https://api.example.com/v1/owners/<ownerId>/pets") {
+			t.Errorf("%s snippet doesn't fill the path placeholder:\n%s", snippet.Label, snippet.Code)
+		}
+		if !strings.Contains(snippet.Code, "Bearer <token>") || !strings.Contains(snippet.Code, "abc-123") {
+			t.Errorf("%s snippet is missing headers:\n%s", snippet.Label, snippet.Code)
+		}
+	}
+}
+
+func TestCurlSnippet(t *testing.T) {
+	got := CurlSnippet(Request{
+		Method:  "PUT",
+		URL:     "https://api.example.com/notes/1",
+		Headers: []models.Header{{Name: "Content-Type", Value: "application/json"}},
+		Body:    `{"text": "it's done"}`,
+	})
+	want := "curl -X PUT 'https://api.example.com/notes/1' \\\n" +
+		"  -H 'Content-Type: application/json' \\\n" +
+		`  -d '{"text": "it'\''s done"}'`
+	if got != want {
+		t.Errorf("CurlSnippet() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGoSnippet(t *testing.T) {
+	got := GoSnippet(Request{Method: "DELETE", URL: "https://api.example.com/notes/1"})
+	if !strings.Contains(got, `http.NewRequest(http.MethodDelete, "https://api.example.com/notes/1", nil)`) {
+		t.Errorf("GoSnippet() unexpected request line:\n%s", got)
+	}
+
+	got = GoSnippet(Request{Method: "POST", URL: "https://api.example.com/notes", Body: "{\"text\": \"`code`\"}"})
+	if !strings.Contains(got, `strings.NewReader("{\"text\": \"`+"`code`"+`\"}")`) {
+		t.Errorf("GoSnippet() should quote bodies containing backticks:\n%s", got)
+	}
+}
+
+func TestPythonSnippet(t *testing.T) {
+	request := BuildRequest("https://api.example.com", nil, createPetEndpoint())
+	got := PythonSnippet(request, true)
+
+	for _, want := range []string{
+		"response = requests.post(\n",
+		`"vaccinated": True,`,
+		`"owner": None,`,
+		"print(response.json())",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("PythonSnippet() missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Content-Type") {
+		t.Errorf("PythonSnippet() should leave the JSON content type to requests:\n%s", got)
+	}
+
+	got = PythonSnippet(Request{Method: "GET", URL: "https://api.example.com/health"}, false)
+	want := "import requests\n\nresponse = requests.get(\"https://api.example.com/health\")\nprint(response.text)"
+	if got != want {
+		t.Errorf("PythonSnippet() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestJavaScriptSnippet(t *testing.T) {
+	request := BuildRequest("https://api.example.com", nil, createPetEndpoint())
+	got := JavaScriptSnippet(request, true)
+
+	for _, want := range []string{
+		`method: "POST",`,
+		`"Content-Type": "application/json",`,
+		"body: JSON.stringify({\n    \"name\": \"Rex\",\n    \"vaccinated\": true,",
+		"console.log(await response.json());",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("JavaScriptSnippet() missing %q in:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/bycait27/readme-generator/internal/exporter"
+)
+
+// TemplateFunc is a function templates can call, with the documentation
+// the list command shows
+type TemplateFunc struct {
+	Name  string
+	Usage string // an example call
+	Doc   string
+	Fn    interface{}
+}
+
+// templateFuncs are the functions templates can call besides the
+// text/template builtins, grouped by what they're for
+var templateFuncs = []TemplateFunc{
+	// text
+	{"join", `{{join ", " .Platforms}}`, "Joins the items of a list with a separator", join},
+	{"lower", `{{lower .Title}}`, "Lower-cases text", strings.ToLower},
+	{"upper", `{{upper .Title}}`, "Upper-cases text", strings.ToUpper},
+	{"title", `{{title .Name}}`, "Upper-cases the first letter of every word", title},
+	{"slug", `{{slug .Title}}`, `Lower-cases text and joins its words with hyphens: "My Tool!" gives "my-tool"`, slug},
+	{"anchor", `[Install]({{anchor "📦 Installation"}})`, "Returns the #link GitHub gives a heading", anchor},
+	{"indent", `{{indent 4 .Example}}`, "Puts spaces before every non-empty line", indent},
+	{"default", `{{default "latest" .Version}}`, "Returns the value, or the fallback when the value is empty", defaultValue},
+	{"pluralize", `{{len .Commands}} {{pluralize (len .Commands) "command"}}`, "Picks the singular or plural of a word for a count; an irregular plural can follow the singular", pluralize},
+
+	// markdown
+	{"code", "{{code .Name}}", "Wraps a value in an inline code span, even one holding backticks", code},
+	{"codeEach", `{{join ", " (codeEach .Values)}}`, "Wraps every item of a list in an inline code span", codeEach},
+	{"codeBlock", `{{codeBlock "bash" .Command}}`, "Fences text as a code block in a language", codeBlock},
+	{"escape", "{{escape .Description}}", "Escapes pipes and backticks so text reads literally", escape},
+	{"cell", "| {{cell .Description}} |", "Makes text safe in a table cell: escapes pipes and turns line breaks into <br>", cell},
+	{"table", `{{table .Commands "Name" "Summary=Description"}}`, `Renders a list of structs or maps as a table; a column is a field, or "Header=Field"`, table},
+
+	// badges
+	{"badge", `![status]({{badge "status" "stable" "green"}})`, "Builds the URL of a static shields.io badge", badge},
+	{"licenseBadge", `![license]({{licenseBadge .License}})`, "Builds the URL of a shields.io badge showing a license", licenseBadge},
+	{"githubBadge", `![stars]({{githubBadge "stars" "owner/repo"}})`, "Builds the URL of a shields.io badge reading a GitHub repository, such as stars or v/release", githubBadge},
+
+	// api docs
+	{"requestSamples", "{{range requestSamples $.APIDocs.BaseURL $.Auth .}}", "Renders an endpoint's example request in several languages", exporter.Snippets},
+	{"parameterGroups", "{{range parameterGroups .}}{{.Title}}{{range .Parameters}}", "Splits an endpoint's parameters by location", exporter.GroupParameters},
+	{"parameterConstraints", "{{parameterConstraints .}}", "Summarizes a parameter's format, enum, range and pattern for a table cell", exporter.ParameterConstraints},
+	{"graphqlExamples", `{{range graphqlExamples .GraphQL .Auth "query"}}`, "Pairs the GraphQL operations of a kind with example documents and variables", exporter.GraphQLExamples},
+	{"grpcExamples", "{{range grpcExamples $.APIDocs $.Auth .}}", "Pairs the methods of a gRPC service with their streaming mode, messages and a grpcurl command", exporter.GRPCExamples},
+	{"grpcTypes", "{{range grpcTypes .}}", "Lists the gRPC messages and enums used inside requests and responses", exporter.GRPCTypes},
+}
+
+// funcMap holds templateFuncs by name
+var funcMap = func() template.FuncMap {
+	funcs := template.FuncMap{}
+	for _, f := range templateFuncs {
+		funcs[f.Name] = f.Fn
+	}
+	return funcs
+}()
+
+// Funcs returns the functions templates can call
+func Funcs() []TemplateFunc {
+	return append([]TemplateFunc(nil), templateFuncs...)
+}
@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestFuncs_Documented(t *testing.T) {
+	for _, f := range Funcs() {
+		if f.Usage == "" || f.Doc == "" {
+			t.Errorf("function %s needs a usage and doc", f.Name)
+		}
+		if !strings.Contains(f.Usage, f.Name) {
+			t.Errorf("the usage of %s should call it, got %s", f.Name, f.Usage)
+		}
+	}
+}
+
+func TestFuncs(t *testing.T) {
+	data := map[string]interface{}{
+		"Platforms": []string{"linux", "macOS", "windows"},
+		"Commands": []models.Command{
+			{Name: "init", Description: "Create a | config"},
+			{Name: "run", Description: "Run it\nfast"},
+		},
+		"Empty":   "",
+		"Version": "1.2.0",
+		"License": "Apache-2.0",
+	}
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{`{{join ", " .Platforms}}`, "linux, macOS, windows"},
+		{`{{join ", " (codeEach .Platforms)}}`, "`linux`, `macOS`, `windows`"},
+		{`{{lower "ReadMe"}} {{upper "ReadMe"}} {{title "hello wide-world"}}`, "readme README Hello Wide-World"},
+		{`{{slug "My CLI Tool!"}}`, "my-cli-tool"},
+		{`{{anchor "## 📦 Installation"}} {{anchor "Quick Start"}}`, "#-installation #quick-start"},
+		{"{{code \"a`b\"}} {{code \"`tick`\"}}", "``a`b`` `` `tick` ``"},
+		{`{{codeBlock "bash" "go run .\n"}}`, "```bash\ngo run .\n```"},
+		{"{{codeBlock \"md\" \"```go\\n```\"}}", "````md\n```go\n```\n````"},
+		{"{{escape \"a|b `c`\"}}", "a\\|b \\`c\\`"},
+		{`{{cell "a|b\nc"}}`, `a\|b<br>c`},
+		{`{{indent 2 "a\n\nb"}}`, "  a\n\n  b"},
+		{`{{default "latest" .Empty}} {{default "latest" .Version}} {{default "none" .Missing}}`, "latest 1.2.0 none"},
+		{`{{pluralize 1 "command"}} {{pluralize 2 "command"}} {{pluralize 0 "proxy"}} {{pluralize 3 "class"}} {{pluralize 2 "key"}} {{pluralize 2 "child" "children"}}`,
+			"command commands proxies classes keys children"},
+		{`{{table .Commands "Name" "Summary=Description"}}`,
+			"| Name | Summary |\n|------|---------|\n| init | Create a \\| config |\n| run | Run it<br>fast |"},
+		{`{{badge "go version" "1.24" "00ADD8"}}`, "https://img.shields.io/badge/go_version-1.24-00ADD8"},
+		{`{{licenseBadge .License}}`, "https://img.shields.io/badge/license-Apache--2.0-blue"},
+		{`{{githubBadge "stars" "https://github.com/bycait27/readme-generator.git"}}`, "https://img.shields.io/github/stars/bycait27/readme-generator"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			tmpl, err := template.New("test").Funcs(funcMap).Parse(tt.template)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			var b strings.Builder
+			if err := tmpl.Execute(&b, data); err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("got %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFuncs_Errors(t *testing.T) {
+	for _, text := range []string{
+		`{{join ", " "not a list"}}`,
+		`{{table .Commands}}`,
+		`{{table .Commands "Missing"}}`,
+	} {
+		tmpl := template.Must(template.New("test").Funcs(funcMap).Parse(text))
+		data := map[string]interface{}{"Commands": []models.Command{{Name: "init"}}}
+		if err := tmpl.Execute(&strings.Builder{}, data); err == nil {
+			t.Errorf("expected an error from %s", text)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -90,3 +91,340 @@ func TestListAvailableTemplates(t *testing.T) {
 		t.Error("basic template should be in available templates list")
 	}
 }
+
+func TestGenerateREADMEToFile_CLITool(t *testing.T) {
+	// Create test data
+	cliTool := &models.CLITool{
+		BaseInfo: models.BaseInfo{
+			Title:       "mytool",
+			Description: "A command line tool that does useful things",
+			License:     "MIT",
+			Author: models.AuthorInfo{
+				Name:   "Test Author",
+				Email:  "test@example.com",
+				GitHub: "https://github.com/testuser",
+			},
+		},
+		QuickStart: models.QuickStart{
+			Commands:    []string{"mytool init"},
+			Description: "Get up and running in seconds",
+		},
+		Installation: models.Installation{
+			PackageManagers: []models.PackageManager{
+				{Name: "go", Command: "go install github.com/testuser/mytool@latest"},
+			},
+			Binary: &models.BinaryInstall{
+				URL:       "https://github.com/testuser/mytool/releases",
+				Platforms: []string{"Linux", "MacOS"},
+			},
+		},
+		Usage: models.Usage{
+			BasicUsage:  "mytool [command] [flags]",
+			Description: "Run a command with optional flags",
+		},
+		Commands: []models.Command{
+			{
+				Name:        "init",
+				Description: "Initialize a new project",
+				Flags: []models.Flag{
+					{Name: "force", Short: "f", Description: "Overwrite existing files", Default: "false"},
+				},
+			},
+		},
+		Examples: []models.Example{
+			{
+				Title:       "Create a project",
+				Description: "Create a project in the current directory",
+				Commands:    []string{"mytool init --force"},
+			},
+		},
+	}
+
+	// Generate README
+	filePath := filepath.Join(t.TempDir(), "CLI.md")
+	err := GenerateREADMEToFile("cli-tool", cliTool, filePath)
+	if err != nil {
+		t.Fatalf("GenerateREADMEToFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read generated README: %v", err)
+	}
+
+	// Verify content
+	contentStr := string(content)
+	if !strings.Contains(contentStr, "go install github.com/testuser/mytool@latest") {
+		t.Error("Generated README doesn't contain package manager install command")
+	}
+	if !strings.Contains(contentStr, "**Supported Platforms:** Linux, MacOS") {
+		t.Error("Generated README doesn't contain binary platforms")
+	}
+	if !strings.Contains(contentStr, "`-f, --force`") {
+		t.Error("Generated README doesn't contain command flags")
+	}
+	if !strings.Contains(contentStr, "### Create a project") {
+		t.Error("Generated README doesn't contain examples")
+	}
+}
+
+func TestGenerateREADMEToFile_APIService(t *testing.T) {
+	// Create test data
+	apiService := &models.APIService{
+		BaseInfo: models.BaseInfo{
+			Title:       "Orders API",
+			Description: "A service that manages customer orders",
+			License:     "MIT",
+			Author: models.AuthorInfo{
+				Name:   "Test Author",
+				Email:  "test@example.com",
+				GitHub: "https://github.com/testuser",
+			},
+		},
+		GettingStarted: models.GettingStarted{
+			RunCommands: []string{"go run ./cmd/server"},
+		},
+		APIDocs: models.APIDocs{
+			BaseURL:        "https://api.example.com",
+			Authentication: "Bearer token",
+			Endpoints: []models.Endpoint{
+				{
+					Method:      "GET",
+					Path:        "/orders",
+					Description: "List all orders",
+					Parameters: []models.Parameter{
+						{Name: "status", Type: "string", Enum: []string{"open", "paid"}, Description: "Filter by status", Example: "open"},
+					},
+					Response: `[{"id": 1}]`,
+				},
+			},
+			GRPC: &models.GRPCDocs{
+				Services: []models.GRPCService{{
+					Name: "orders.v1.OrderService",
+					Methods: []models.GRPCMethod{
+						{Name: "WatchOrders", Request: "orders.v1.WatchRequest", Response: "orders.v1.Order", ServerStreaming: true},
+					},
+				}},
+				Messages: []models.GRPCMessage{
+					{Name: "orders.v1.WatchRequest", Kind: "message", Fields: []models.GRPCField{{Name: "status", Type: "string", Description: "Only orders in this status"}}},
+				},
+			},
+		},
+		Auth: &models.Auth{
+			Method:      "jwt",
+			TokenFormat: "Authorization: Bearer <token>",
+			Endpoints:   []string{"/login"},
+		},
+		Monitoring: &models.Monitoring{
+			HealthCheck: "curl https://api.example.com/healthz",
+			Logging:     models.LoggingConfig{Level: "INFO", Format: "json", Output: "stdout"},
+		},
+	}
+
+	// Generate README
+	filePath := filepath.Join(t.TempDir(), "API.md")
+	err := GenerateREADMEToFile("api-service", apiService, filePath)
+	if err != nil {
+		t.Fatalf("GenerateREADMEToFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read generated README: %v", err)
+	}
+
+	// Verify content
+	contentStr := string(content)
+	if !strings.Contains(contentStr, "#### GET /orders") {
+		t.Error("Generated README doesn't contain endpoints")
+	}
+	if !strings.Contains(contentStr, "**Query Parameters:**") || !strings.Contains(contentStr, "| `status` | string | ❌ | Filter by status | one of `open`, `paid` | `open` |") {
+		t.Error("Generated README doesn't contain the parameter table")
+	}
+	if !strings.Contains(contentStr, "<summary>curl</summary>") || !strings.Contains(contentStr, "curl 'https://api.example.com/orders?status=open' \\\n  -H 'Authorization: Bearer <token>'") {
+		t.Error("Generated README doesn't contain request samples")
+	}
+	if !strings.Contains(contentStr, "#### `WatchOrders` (server streaming)") || !strings.Contains(contentStr, "| `status` | `string` |  | Only orders in this status |") {
+		t.Error("Generated README doesn't contain gRPC methods")
+	}
+	if !strings.Contains(contentStr, "grpcurl -H 'Authorization: Bearer <token>' \\\n  -d '{\"status\":\"text\"}' \\\n  api.example.com:443 orders.v1.OrderService/WatchOrders") {
+		t.Error("Generated README doesn't contain grpcurl examples")
+	}
+	if !strings.Contains(contentStr, "**Method:** jwt") {
+		t.Error("Generated README doesn't contain auth method")
+	}
+	if !strings.Contains(contentStr, "- Level: INFO") {
+		t.Error("Generated README doesn't contain logging config")
+	}
+}
+
+func TestGenerateREADMEToFile_APIService_GraphQL(t *testing.T) {
+	// Create test data for a service with only a GraphQL API
+	apiService := &models.APIService{
+		BaseInfo: models.BaseInfo{
+			Title:       "Orders API",
+			Description: "A service that manages customer orders",
+			License:     "MIT",
+			Author: models.AuthorInfo{
+				Name:   "Test Author",
+				Email:  "test@example.com",
+				GitHub: "https://github.com/testuser",
+			},
+		},
+		GettingStarted: models.GettingStarted{
+			RunCommands: []string{"go run ./cmd/server"},
+		},
+		GraphQL: &models.GraphQLDocs{
+			Endpoint: "https://api.example.com/graphql",
+			Schema:   "graph/schema.graphqls",
+			Queries: []models.GraphQLOperation{
+				{Name: "order", Type: "Order", Description: "Get an order", Arguments: []models.GraphQLArgument{{Name: "id", Type: "ID!"}}},
+			},
+			Types: []models.GraphQLType{
+				{Name: "Order", Kind: "type", Fields: []models.GraphQLField{{Name: "id", Type: "ID!"}, {Name: "total", Type: "Float"}}},
+			},
+		},
+	}
+
+	// Generate README
+	filePath := filepath.Join(t.TempDir(), "API.md")
+	err := GenerateREADMEToFile("api-service", apiService, filePath)
+	if err != nil {
+		t.Fatalf("GenerateREADMEToFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read generated README: %v", err)
+	}
+
+	// Verify content
+	contentStr := string(content)
+	if strings.Contains(contentStr, "API Documentation") {
+		t.Error("Generated README contains an empty REST section")
+	}
+	if !strings.Contains(contentStr, "## 🔷 GraphQL API") || !strings.Contains(contentStr, "#### `order`: `Order`") {
+		t.Error("Generated README doesn't contain GraphQL queries")
+	}
+	if !strings.Contains(contentStr, "query Order($id: ID!) {\n  order(id: $id) {\n    id\n    total\n  }\n}") {
+		t.Error("Generated README doesn't contain the example query")
+	}
+	if !strings.Contains(contentStr, "| `total` | `Float` |  |") {
+		t.Error("Generated README doesn't contain the types")
+	}
+}
+
+func TestGenerateREADMEToFile_APIService_GRPC(t *testing.T) {
+	// Create test data for a service with only a gRPC API
+	apiService := &models.APIService{
+		BaseInfo: models.BaseInfo{
+			Title:       "Orders API",
+			Description: "A service that manages customer orders",
+			License:     "MIT",
+			Author: models.AuthorInfo{
+				Name:   "Test Author",
+				Email:  "test@example.com",
+				GitHub: "https://github.com/testuser",
+			},
+		},
+		GettingStarted: models.GettingStarted{
+			RunCommands: []string{"go run ./cmd/server"},
+		},
+		APIDocs: models.APIDocs{
+			BaseURL:        "https://api.example.com",
+			Authentication: "None",
+			GRPC: &models.GRPCDocs{
+				Address: "grpc://localhost:50051",
+				Services: []models.GRPCService{{
+					Name:    "orders.v1.OrderService",
+					Methods: []models.GRPCMethod{{Name: "GetOrder", Request: "orders.v1.GetOrderRequest", Response: "orders.v1.Order"}},
+				}},
+			},
+			ErrorHandling: &models.ErrorHandling{
+				Format:      "json",
+				StatusCodes: []models.StatusCode{{Code: 404, Description: "The order doesn't exist"}},
+			},
+		},
+	}
+
+	// Generate README
+	filePath := filepath.Join(t.TempDir(), "API.md")
+	err := GenerateREADMEToFile("api-service", apiService, filePath)
+	if err != nil {
+		t.Fatalf("GenerateREADMEToFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read generated README: %v", err)
+	}
+
+	// Verify content
+	contentStr := string(content)
+	if strings.Contains(contentStr, "API Documentation") {
+		t.Error("Generated README contains an empty REST section")
+	}
+	if !strings.Contains(contentStr, "**Server:** grpc://localhost:50051") {
+		t.Error("Generated README doesn't contain the gRPC server address")
+	}
+	if !strings.Contains(contentStr, "### Error Handling") || !strings.Contains(contentStr, "- `404` - The order doesn't exist") {
+		t.Error("Generated README doesn't contain error handling")
+	}
+	if strings.Count(contentStr, "### Error Handling") != 1 {
+		t.Error("Generated README repeats error handling")
+	}
+}
+
+func TestGenerateREADMEToFile_FullStackApp(t *testing.T) {
+	// Create test data
+	app := &models.FullStackApp{
+		BaseInfo: models.BaseInfo{
+			Title:       "Shop",
+			Description: "An online shop with a React frontend",
+			License:     "MIT",
+			Author: models.AuthorInfo{
+				Name:   "Test Author",
+				Email:  "test@example.com",
+				GitHub: "https://github.com/testuser",
+			},
+		},
+		Architecture: &models.Architecture{
+			Pattern: "mern",
+			Components: []models.Component{
+				{Name: "web", Description: "Customer facing UI", Technology: "React"},
+			},
+		},
+		GettingStarted: models.GettingStarted{
+			RunCommands: []string{"npm start"},
+		},
+		AppStructure: &models.AppStructure{
+			Frontend: &models.FrontendStructure{Framework: "React", EntryPoint: "src/index.tsx"},
+			Backend:  &models.BackendStructure{Framework: "Express"},
+		},
+		Development: &models.Development{DevServer: "npm run dev", HotReload: true},
+	}
+
+	// Generate README
+	filePath := filepath.Join(t.TempDir(), "FULLSTACK.md")
+	err := GenerateREADMEToFile("fullstack", app, filePath)
+	if err != nil {
+		t.Fatalf("GenerateREADMEToFile failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read generated README: %v", err)
+	}
+
+	// Verify content
+	contentStr := string(content)
+	if !strings.Contains(contentStr, "**Pattern:** mern") {
+		t.Error("Generated README doesn't contain architecture pattern")
+	}
+	if !strings.Contains(contentStr, "### Frontend (React)") {
+		t.Error("Generated README doesn't contain frontend structure")
+	}
+	if !strings.Contains(contentStr, "**Hot Reload:** Enabled") {
+		t.Error("Generated README doesn't contain development details")
+	}
+}
@@ -0,0 +1,161 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"gopkg.in/yaml.v3"
+)
+
+// frontMatter is the YAML header a template may start with, between ---
+// lines:
+//
+//	---
+//	extends: cli-tool
+//	---
+//	{{define "installation"}}...{{end}}
+type frontMatter struct {
+	// Extends names the template whose layout this one inherits. The
+	// template then only holds {{define}} blocks, replacing the parent's
+	// blocks of the same name such as "installation", or filling the empty
+	// "extra" block for sections of its own. A template may extend the
+	// template it overrides, e.g. a local basic.md extending the built-in
+	// basic.
+	Extends string `yaml:"extends"`
+}
+
+// templateFile is one template of an inheritance chain
+type templateFile struct {
+	name    string
+	source  string // path of the file, or "built-in"
+	body    string // the template without its front matter
+	extends string
+}
+
+// templateChain reads a template and the templates it extends, the
+// template itself first and the base layout last
+func templateChain(layers layeredFS, name string) ([]templateFile, error) {
+	var chain []templateFile
+	from := 0
+	seen := map[string]bool{}
+	for {
+		if err := CheckTemplateName(name); err != nil {
+			return nil, err
+		}
+		layer, err := layers.locate(name+".md", from)
+		if err != nil {
+			return nil, err
+		}
+		source := layerSource(layer, name+".md")
+		if seen[source] {
+			return nil, fmt.Errorf("template %s extends itself through %s", chain[0].name, source)
+		}
+		seen[source] = true
+
+		data, err := fs.ReadFile(layers[layer], name+".md")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", source, err)
+		}
+		header, body, err := splitFrontMatter(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", source, err)
+		}
+		chain = append(chain, templateFile{name: name, source: source, body: body, extends: header.Extends})
+		if header.Extends == "" {
+			return chain, nil
+		}
+
+		// a template extending its own name inherits from the next layer down
+		from = 0
+		if header.Extends == name {
+			from = layer + 1
+		}
+		name = header.Extends
+	}
+}
+
+// BaseTemplate returns the template a template's layout comes from,
+// following extends: keys. It's the template itself unless it extends
+// another one.
+func BaseTemplate(name string) (string, error) {
+	layers, _, err := findTemplate(name)
+	if err != nil {
+		return "", err
+	}
+	chain, err := templateChain(layers, name)
+	if err != nil {
+		return "", err
+	}
+	return chain[len(chain)-1].name, nil
+}
+
+// parseChain parses an inheritance chain into tmpl, base layout first so
+// every template's blocks replace those of the templates it extends. It
+// returns the name of the template to execute: the base layout's.
+func parseChain(tmpl *template.Template, chain []templateFile) (string, error) {
+	base := chain[len(chain)-1]
+	for i := len(chain) - 1; i >= 0; i-- {
+		file := chain[i]
+		// the templates extending the base are named after their files,
+		// which keeps them apart when one extends its own name
+		name := file.source
+		if i == len(chain)-1 {
+			name = base.name + ".md"
+		}
+		t, err := tmpl.New(name).Parse(file.body)
+		if err != nil {
+			return "", fmt.Errorf("failed to load template %s (%s): %w", file.name, file.source, err)
+		}
+		if file.extends != "" && hasContent(t.Tree) {
+			return "", fmt.Errorf("template %s (%s) extends %s, so everything in it must be inside {{define}} blocks", file.name, file.source, file.extends)
+		}
+	}
+	return base.name + ".md", nil
+}
+
+// hasContent reports whether a template has anything outside its
+// {{define}} blocks besides whitespace and comments
+func hasContent(tree *parse.Tree) bool {
+	if tree == nil {
+		return false
+	}
+	for _, node := range tree.Root.Nodes {
+		text, ok := node.(*parse.TextNode)
+		if !ok || strings.TrimSpace(string(text.Text)) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// splitFrontMatter separates a leading YAML block between --- lines from
+// the template body. The block is replaced by a comment spanning as many
+// lines so errors still point at the right line.
+func splitFrontMatter(content string) (frontMatter, string, error) {
+	var header frontMatter
+	content = strings.TrimPrefix(content, "\uFEFF")
+	rest, ok := strings.CutPrefix(content, "---\n")
+	if !ok {
+		rest, ok = strings.CutPrefix(content, "---\r\n")
+	}
+	if !ok {
+		return header, content, nil
+	}
+
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return header, "", fmt.Errorf("front matter is missing its closing ---")
+	}
+	block := rest[:end]
+	body := rest[end+len("\n---"):]
+	body = strings.TrimPrefix(strings.TrimPrefix(body, "\r"), "\n")
+
+	if err := yaml.Unmarshal([]byte(block), &header); err != nil {
+		return header, "", fmt.Errorf("invalid front matter: %w", err)
+	}
+	lines := strings.Count(block, "\n") + 3
+	return header, "{{/*" + strings.Repeat("\n", lines) + "*/}}" + body, nil
+}
@@ -0,0 +1,299 @@
+package generator
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// shieldsURL is where the badge helpers point
+const shieldsURL = "https://img.shields.io"
+
+// join joins the items of a list with sep
+func join(sep string, items interface{}) (string, error) {
+	values, err := toStrings(items)
+	if err != nil {
+		return "", fmt.Errorf("join: %w", err)
+	}
+	return strings.Join(values, sep), nil
+}
+
+// toStrings formats every item of a slice or array
+func toStrings(items interface{}) ([]string, error) {
+	if items == nil {
+		return nil, nil
+	}
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a list, got %T", items)
+	}
+	values := make([]string, v.Len())
+	for i := range values {
+		values[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+	return values, nil
+}
+
+// title upper-cases the first letter of every word
+func title(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if i == 0 || !unicode.IsLetter(runes[i-1]) && !unicode.IsNumber(runes[i-1]) && runes[i-1] != '\'' {
+			runes[i] = unicode.ToUpper(r)
+		}
+	}
+	return string(runes)
+}
+
+// slug lower-cases s and joins its words with hyphens, for file names and
+// ids: "My CLI Tool!" becomes "my-cli-tool"
+func slug(s string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
+
+// anchor returns the link GitHub gives a heading: punctuation and emoji
+// are dropped and spaces become hyphens, so "## 📦 Installation" links as
+// "#-installation"
+func anchor(heading string) string {
+	var b strings.Builder
+	b.WriteByte('#')
+	for _, r := range strings.ToLower(strings.TrimSpace(strings.TrimLeft(heading, "#"))) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || r == '-' || r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteByte('-')
+		}
+	}
+	return b.String()
+}
+
+// code wraps s in an inline code span, using more backticks than s holds
+// in a row
+func code(s interface{}) string {
+	text := fmt.Sprint(s)
+	fence := strings.Repeat("`", longestRun(text, '`')+1)
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+	return fence + text + fence
+}
+
+// codeEach wraps every item of a list in an inline code span
+func codeEach(items interface{}) ([]string, error) {
+	values, err := toStrings(items)
+	if err != nil {
+		return nil, fmt.Errorf("codeEach: %w", err)
+	}
+	for i, value := range values {
+		values[i] = code(value)
+	}
+	return values, nil
+}
+
+// codeBlock fences s as a code block in a language. The fence is longer
+// than any run of backticks in s so the block can't end early.
+func codeBlock(lang string, s interface{}) string {
+	text := strings.TrimRight(fmt.Sprint(s), "\n")
+	fence := strings.Repeat("`", max(3, longestRun(text, '`')+1))
+	return fence + lang + "\n" + text + "\n" + fence
+}
+
+func longestRun(s string, c rune) int {
+	longest, run := 0, 0
+	for _, r := range s {
+		if r != c {
+			run = 0
+			continue
+		}
+		run++
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+// escape escapes the pipes and backticks in s so it reads literally in
+// Markdown, including inside tables
+func escape(s interface{}) string {
+	return strings.NewReplacer("|", `\|`, "`", "\\`").Replace(fmt.Sprint(s))
+}
+
+// cell makes s safe in a table cell, escaping pipes and turning line
+// breaks into <br>, while keeping other Markdown such as code spans
+func cell(s interface{}) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace(fmt.Sprint(s))
+}
+
+// table renders a list of structs or maps as a Markdown table. Each column
+// is a field name, or "Header=Field" to show the field under another
+// header; fields of nested structs are reached with dots, as in
+// "Author.Name".
+func table(rows interface{}, columns ...string) (string, error) {
+	if len(columns) == 0 {
+		return "", fmt.Errorf("table: no columns")
+	}
+	headers := make([]string, len(columns))
+	fields := make([]string, len(columns))
+	for i, column := range columns {
+		header, field, ok := strings.Cut(column, "=")
+		if !ok {
+			field = header
+		}
+		headers[i], fields[i] = header, field
+	}
+
+	var b strings.Builder
+	b.WriteString("|")
+	for _, header := range headers {
+		b.WriteString(" " + cell(header) + " |")
+	}
+	b.WriteString("\n|")
+	for _, header := range headers {
+		b.WriteString(strings.Repeat("-", len(cell(header))+2) + "|")
+	}
+
+	v := reflect.ValueOf(rows)
+	if rows != nil && v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return "", fmt.Errorf("table: expected a list, got %T", rows)
+	}
+	for i := 0; rows != nil && i < v.Len(); i++ {
+		b.WriteString("\n|")
+		for _, field := range fields {
+			value, err := fieldValue(v.Index(i), field)
+			if err != nil {
+				return "", fmt.Errorf("table: row %d: %w", i+1, err)
+			}
+			b.WriteString(" " + cell(value) + " |")
+		}
+	}
+	return b.String(), nil
+}
+
+// fieldValue follows a dotted path of struct fields and map keys. A nil
+// pointer on the way gives an empty value.
+func fieldValue(v reflect.Value, path string) (string, error) {
+	for _, name := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return "", nil
+			}
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Struct:
+			v = v.FieldByName(name)
+			if !v.IsValid() {
+				return "", fmt.Errorf("no field %s", name)
+			}
+		case reflect.Map:
+			v = v.MapIndex(reflect.ValueOf(name))
+			if !v.IsValid() {
+				return "", nil
+			}
+		default:
+			return "", fmt.Errorf("can't read %s of %s", name, v.Type())
+		}
+	}
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	return fmt.Sprint(v.Interface()), nil
+}
+
+// indent puts n spaces before every non-empty line of s
+func indent(n int, s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = strings.Repeat(" ", n) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// defaultValue returns value, or fallback when value is empty: nil, zero,
+// or an empty string, list or map
+func defaultValue(fallback, value interface{}) interface{} {
+	if value == nil {
+		return fallback
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if v.Len() == 0 {
+			return fallback
+		}
+	default:
+		if v.IsZero() {
+			return fallback
+		}
+	}
+	return value
+}
+
+// pluralize picks the singular or plural form of a word for count. The
+// plural defaults to the usual English ending: command -> commands,
+// proxy -> proxies, class -> classes.
+func pluralize(count int, singular string, plural ...string) string {
+	if count == 1 {
+		return singular
+	}
+	if len(plural) > 0 {
+		return plural[0]
+	}
+	lower := strings.ToLower(singular)
+	switch {
+	case len(lower) > 1 && lower[len(lower)-1] == 'y' && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return singular[:len(singular)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return singular + "es"
+	}
+	return singular + "s"
+}
+
+// badge builds the URL of a static shields.io badge
+func badge(label, message, color string) string {
+	part := func(s string) string {
+		s = strings.NewReplacer("-", "--", "_", "__", " ", "_").Replace(s)
+		return url.PathEscape(s)
+	}
+	if label == "" {
+		return shieldsURL + "/badge/" + part(message) + "-" + part(color)
+	}
+	return shieldsURL + "/badge/" + part(label) + "-" + part(message) + "-" + part(color)
+}
+
+// licenseBadge builds the URL of a badge showing a license
+func licenseBadge(license string) string {
+	return badge("license", license, "blue")
+}
+
+// githubBadge builds the URL of a shields.io badge that reads a GitHub
+// repository, such as its stars or latest release. repo is owner/name or
+// the repository's URL.
+func githubBadge(kind, repo string) string {
+	repo = strings.TrimPrefix(repo, "https://")
+	repo = strings.TrimPrefix(repo, "http://")
+	repo = strings.TrimPrefix(repo, "github.com/")
+	repo = strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+	return shieldsURL + "/github/" + strings.Trim(kind, "/") + "/" + repo
+}
@@ -0,0 +1,193 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/config"
+	"github.com/bycait27/readme-generator/templates"
+)
+
+// TemplatesEnv names the environment variable holding a templates
+// directory
+const TemplatesEnv = "READMEGEN_TEMPLATES"
+
+// LocalTemplatesDir is the project's own templates directory
+var LocalTemplatesDir = filepath.Join(".readme-gen", "templates")
+
+// templateName matches names that stay inside a templates directory
+var templateName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// templatesDir is the --templates-dir flag, searched before anything else
+var templatesDir string
+
+// SetTemplatesDir sets a directory searched before all others
+func SetTemplatesDir(dir string) {
+	templatesDir = dir
+}
+
+// SearchPath returns the directories searched for templates, in order:
+// the --templates-dir flag, $READMEGEN_TEMPLATES, ./.readme-gen/templates
+// and the templates directory in the user config dir. A template found in
+// one of them overrides the built-in template of the same name.
+func SearchPath() []string {
+	var dirs []string
+	for _, dir := range []string{templatesDir, os.Getenv(TemplatesEnv), LocalTemplatesDir} {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	if dir, err := config.Dir(); err == nil {
+		dirs = append(dirs, filepath.Join(dir, "templates"))
+	}
+	return dirs
+}
+
+// CheckTemplateName rejects names that could reach outside a templates
+// directory, such as ../../etc/passwd
+func CheckTemplateName(name string) error {
+	if !templateName.MatchString(name) {
+		return fmt.Errorf("invalid template name %q: use letters, digits, - and _ only", name)
+	}
+	return nil
+}
+
+// PartialsDir is where partials live inside a templates directory. Each
+// partial file holds {{define "name"}} blocks that every template can
+// call with {{template "name" .}}.
+const PartialsDir = "partials"
+
+// findTemplate checks that a template exists and returns the layered file
+// system it and its partials are read from. source is the path of the
+// template file, or "built-in".
+func findTemplate(name string) (fsys layeredFS, source string, err error) {
+	if err := CheckTemplateName(name); err != nil {
+		return nil, "", err
+	}
+
+	layers := templateLayers()
+	layer, err := layers.locate(name+".md", 0)
+	if err != nil {
+		return nil, "", err
+	}
+	return layers, layerSource(layer, name+".md"), nil
+}
+
+// layerSource describes where the file at path in a layer of
+// templateLayers comes from
+func layerSource(layer int, path string) string {
+	dirs := SearchPath()
+	if layer < len(dirs) {
+		return filepath.Join(dirs[layer], path)
+	}
+	return "built-in"
+}
+
+// layeredFS stacks file systems: a file in an earlier layer hides the
+// file at the same path in later ones, and directories list the files of
+// every layer
+type layeredFS []fs.FS
+
+// templateLayers stacks the search path over the built-in templates
+func templateLayers() layeredFS {
+	var layers layeredFS
+	for _, dir := range SearchPath() {
+		layers = append(layers, os.DirFS(dir))
+	}
+	return append(layers, templates.FS)
+}
+
+// locate returns the index of the first layer, starting at from, that
+// holds the file at path
+func (l layeredFS) locate(path string, from int) (int, error) {
+	for i := from; i < len(l); i++ {
+		info, err := fs.Stat(l[i], path)
+		if err == nil && !info.IsDir() {
+			return i, nil
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return -1, fmt.Errorf("failed to read template %s: %w", layerSource(i, path), err)
+		}
+	}
+	if from > 0 {
+		return -1, fmt.Errorf("template %s not found below %s", strings.TrimSuffix(path, ".md"), layerSource(from-1, path))
+	}
+	return -1, fmt.Errorf("template %s not found in %s or the built-in templates", strings.TrimSuffix(path, ".md"), strings.Join(SearchPath(), ", "))
+}
+
+func (l layeredFS) Open(name string) (fs.File, error) {
+	for _, layer := range l {
+		f, err := layer.Open(name)
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return f, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (l layeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := map[string]bool{}
+	var entries []fs.DirEntry
+	found := false
+	for _, layer := range l {
+		layerEntries, err := fs.ReadDir(layer, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		found = true
+		for _, entry := range layerEntries {
+			if !seen[entry.Name()] {
+				seen[entry.Name()] = true
+				entries = append(entries, entry)
+			}
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// TemplateSource returns the path of the file a template is loaded from,
+// or "built-in" for an embedded template
+func TemplateSource(name string) (string, error) {
+	_, source, err := findTemplate(name)
+	return source, err
+}
+
+// templateNames lists the <name>.md templates in a file system
+func templateNames(fsys fs.FS) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".md")
+		if ok && !entry.IsDir() && templateName.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// ListAvailableTemplates returns the names of the built-in templates and
+// of those on the search path, sorted
+func ListAvailableTemplates() ([]string, error) {
+	names, err := templateNames(templateLayers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+	return names, nil
+}
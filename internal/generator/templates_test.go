@@ -0,0 +1,254 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/config"
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// isolateTemplates points every templates directory at empty temporary
+// ones so the user's own templates don't leak into a test
+func isolateTemplates(t *testing.T) {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(TemplatesEnv, "")
+	t.Chdir(t.TempDir())
+	t.Cleanup(func() { SetTemplatesDir("") })
+}
+
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRender_BuiltIn(t *testing.T) {
+	isolateTemplates(t)
+
+	source, err := TemplateSource("basic")
+	if err != nil || source != "built-in" {
+		t.Errorf("TemplateSource() = %q, %v, want the built-in template", source, err)
+	}
+	content, err := Render("basic", map[string]interface{}{"Title": "Embedded"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !strings.Contains(string(content), "Embedded") {
+		t.Errorf("expected the built-in template to render, got:\n%s", content)
+	}
+}
+
+func TestRender_SearchPath(t *testing.T) {
+	isolateTemplates(t)
+	flagDir := t.TempDir()
+	envDir := t.TempDir()
+	userDir, err := config.Dir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	configDir := filepath.Join(userDir, "templates")
+
+	writeTemplate(t, configDir, "basic", "config {{.Title}}")
+	writeTemplate(t, configDir, "custom", "custom {{.Title}}")
+	writeTemplate(t, LocalTemplatesDir, "basic", "local {{.Title}}")
+	writeTemplate(t, envDir, "basic", "env {{.Title}}")
+	writeTemplate(t, flagDir, "basic", "flag {{.Title}}")
+
+	render := func(want string) {
+		t.Helper()
+		content, err := Render("basic", map[string]string{"Title": "x"})
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		if string(content) != want+" x" {
+			t.Errorf("Render() = %q, want the %s template", content, want)
+		}
+	}
+
+	// each directory overrides the ones after it
+	SetTemplatesDir(flagDir)
+	t.Setenv(TemplatesEnv, envDir)
+	render("flag")
+	SetTemplatesDir("")
+	render("env")
+	t.Setenv(TemplatesEnv, "")
+	render("local")
+	os.RemoveAll(LocalTemplatesDir)
+	render("config")
+
+	names, err := ListAvailableTemplates()
+	if err != nil {
+		t.Fatalf("ListAvailableTemplates failed: %v", err)
+	}
+	if !slices.Contains(names, "custom") || !slices.Contains(names, "api-service") {
+		t.Errorf("expected built-in and custom templates, got %v", names)
+	}
+}
+
+func TestRender_InvalidName(t *testing.T) {
+	isolateTemplates(t)
+
+	for _, name := range []string{"../../etc/passwd", "..", "/etc/passwd", "sub/basic", `..\basic`, ""} {
+		if _, err := Render(name, nil); err == nil || !strings.Contains(err.Error(), "invalid template name") {
+			t.Errorf("Render(%q) error = %v, want an invalid name error", name, err)
+		}
+		if err := ValidateTemplate(name); err == nil {
+			t.Errorf("ValidateTemplate(%q) should fail", name)
+		}
+	}
+}
+
+func TestRender_Partials(t *testing.T) {
+	isolateTemplates(t)
+
+	// a user partial restyles the block in every template
+	writeTemplate(t, filepath.Join(LocalTemplatesDir, PartialsDir), "contact",
+		`{{define "contact"}}Reach {{.Author.Name}} at {{.Author.Email}}{{end}}`)
+	info := &models.BaseInfo{
+		Title:  "Partials",
+		Author: models.AuthorInfo{Name: "Test Author", Email: "test@example.com"},
+	}
+	for name, data := range map[string]interface{}{"basic": info, "cli-tool": &models.CLITool{BaseInfo: *info}} {
+		content, err := Render(name, data)
+		if err != nil {
+			t.Fatalf("Render(%s) failed: %v", name, err)
+		}
+		if !strings.Contains(string(content), "Reach Test Author at test@example.com") || strings.Contains(string(content), "## Contact") {
+			t.Errorf("expected the user's contact partial in %s, got:\n%s", name, content)
+		}
+		// built-in partials the user didn't override still apply
+		if !strings.Contains(string(content), "## 📄 License") {
+			t.Errorf("expected the built-in license partial in %s", name)
+		}
+	}
+
+	// a partial file can't replace the template being rendered
+	writeTemplate(t, filepath.Join(LocalTemplatesDir, PartialsDir), "basic", "hijacked")
+	content, err := Render("basic", info)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if strings.Contains(string(content), "hijacked") {
+		t.Errorf("a partial replaced the template:\n%s", content)
+	}
+
+	writeTemplate(t, filepath.Join(LocalTemplatesDir, PartialsDir), "broken", `{{define "broken"}}{{if}}{{end}}`)
+	if _, err := Render("basic", info); err == nil {
+		t.Error("expected an error for a broken partial")
+	}
+}
+
+func TestRender_Extends(t *testing.T) {
+	isolateTemplates(t)
+	info := &models.CLITool{BaseInfo: models.BaseInfo{Title: "Extends", License: "MIT"}}
+
+	// a company template replaces one section and adds another
+	writeTemplate(t, LocalTemplatesDir, "company-cli", `---
+extends: cli-tool
+---
+{{define "installation"}}## 📦 Installation
+
+Install {{.Title}} from the internal package registry.
+{{end}}
+{{define "extra"}}## 🛡️ Compliance
+
+Reviewed by the security team.
+
+{{end}}`)
+	content, err := Render("company-cli", info)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	for _, want := range []string{"# Extends", "Install Extends from the internal package registry.", "## 🚀 Usage", "## 🛡️ Compliance\n\nReviewed by the security team.\n\n## 📄 License"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("expected %q in the extended template, got:\n%s", want, content)
+		}
+	}
+	if base, err := BaseTemplate("company-cli"); err != nil || base != "cli-tool" {
+		t.Errorf("BaseTemplate() = %q, %v, want cli-tool", base, err)
+	}
+
+	// a template may extend the built-in template it overrides
+	writeTemplate(t, LocalTemplatesDir, "basic", "---\nextends: basic\n---\n{{define \"extra\"}}Local extra\n\n{{end}}")
+	content, err = Render("basic", &info.BaseInfo)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !strings.Contains(string(content), "# Extends") || !strings.Contains(string(content), "Local extra") {
+		t.Errorf("expected the built-in basic layout with the local block, got:\n%s", content)
+	}
+	if base, err := BaseTemplate("basic"); err != nil || base != "basic" {
+		t.Errorf("BaseTemplate() = %q, %v, want basic", base, err)
+	}
+}
+
+func TestRender_ExtendsErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates map[string]string
+		wantErr   string
+	}{
+		{
+			name: "cycle",
+			templates: map[string]string{
+				"first":  "---\nextends: second\n---\n",
+				"second": "---\nextends: first\n---\n",
+			},
+			wantErr: "extends itself",
+		},
+		{
+			name:      "missing parent",
+			templates: map[string]string{"first": "---\nextends: nonexistent\n---\n"},
+			wantErr:   "nonexistent",
+		},
+		{
+			name:      "content outside define",
+			templates: map[string]string{"first": "---\nextends: basic\n---\nstray text\n"},
+			wantErr:   "must be inside {{define}} blocks",
+		},
+		{
+			name:      "unclosed front matter",
+			templates: map[string]string{"first": "---\nextends: basic\n"},
+			wantErr:   "closing ---",
+		},
+		{
+			name:      "invalid front matter",
+			templates: map[string]string{"first": "---\nextends: [basic\n---\n"},
+			wantErr:   "invalid front matter",
+		},
+		{
+			name:      "invalid parent name",
+			templates: map[string]string{"first": "---\nextends: ../basic\n---\n"},
+			wantErr:   "invalid template name",
+		},
+		{
+			// line numbers still count the front matter
+			name:      "parse error",
+			templates: map[string]string{"first": "---\nextends: basic\n---\n\n{{define \"extra\"}}{{if}}{{end}}"},
+			wantErr:   ":5:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateTemplates(t)
+			for name, content := range tt.templates {
+				writeTemplate(t, LocalTemplatesDir, name, content)
+			}
+			_, err := Render("first", &models.BaseInfo{Title: "Errors"})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Render() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
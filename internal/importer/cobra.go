@@ -0,0 +1,545 @@
+package importer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+const cobraImportPath = "github.com/spf13/cobra"
+
+// flagMethodPattern splits pflag registration methods such as StringVarP
+// into their type, Var and P (shorthand) parts
+var flagMethodPattern = regexp.MustCompile(`^([A-Z][A-Za-z0-9]*?)(Var)?(P)?$`)
+
+// cobraCommand is a cobra.Command literal found in the source
+type cobraCommand struct {
+	use, short, long string
+	hidden           bool
+	flags            []models.Flag
+	children         []string
+	isChild          bool
+}
+
+// cobraCall is a call on a command that can only be resolved once every
+// command literal is known
+type cobraCall struct {
+	scope, receiver string
+	apply           func(cmd *cobraCommand, resolve func(scope string, expr ast.Expr) string)
+}
+
+// cobraSource collects the commands and calls of every scanned file
+type cobraSource struct {
+	commands map[string]*cobraCommand
+	order    []string
+	aliases  map[string]string
+	calls    []cobraCall
+}
+
+// CobraCommands statically analyses the Go source under dir for
+// cobra.Command literals, their flag registrations and AddCommand calls.
+// Subcommands are named by their path below the root command, e.g.
+// "profile set". The root command is only listed when it has flags of its
+// own or no subcommands.
+func CobraCommands(dir string) ([]models.Command, error) {
+	source := &cobraSource{
+		commands: make(map[string]*cobraCommand),
+		aliases:  make(map[string]string),
+	}
+	err := walkGoFiles(dir, func(fset *token.FileSet, file *ast.File) {
+		cobraName := importName(file, cobraImportPath)
+		if cobraName == "" {
+			return
+		}
+		pkg, _ := filepath.Rel(dir, filepath.Dir(fset.Position(file.Pos()).Filename))
+		source.scanFile(pkg, cobraName, file)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return source.resolve(), nil
+}
+
+func (s *cobraSource) scanFile(pkg, cobraName string, file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			s.scanValueSpecs(pkg, cobraName, decl)
+		case *ast.FuncDecl:
+			if decl.Body == nil {
+				continue
+			}
+			scope := pkg + "." + decl.Name.Name
+			ast.Inspect(decl.Body, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.DeclStmt:
+					if gen, ok := n.Decl.(*ast.GenDecl); ok {
+						s.scanValueSpecs(scope, cobraName, gen)
+					}
+				case *ast.AssignStmt:
+					for i, rhs := range n.Rhs {
+						if i >= len(n.Lhs) {
+							break
+						}
+						ident, ok := n.Lhs[i].(*ast.Ident)
+						if !ok {
+							continue
+						}
+						if lit := cobraLiteral(rhs, cobraName); lit != nil {
+							s.define(scope+"."+ident.Name, lit)
+						} else if receiver, ok := flagSetCall(rhs); ok {
+							// flags := cmd.Flags()
+							s.aliases[scope+"."+ident.Name+".flags"] = receiver
+						}
+					}
+				case *ast.ReturnStmt:
+					if len(n.Results) == 0 {
+						break
+					}
+					// constructors such as newServeCmd() *cobra.Command
+					key := pkg + "." + decl.Name.Name + "()"
+					if lit := cobraLiteral(n.Results[0], cobraName); lit != nil {
+						s.define(key, lit)
+					} else if ident, ok := n.Results[0].(*ast.Ident); ok {
+						s.aliases[key] = scope + "." + ident.Name
+					}
+				case *ast.CallExpr:
+					s.scanCall(scope, n)
+				}
+				return true
+			})
+		}
+	}
+}
+
+func (s *cobraSource) scanValueSpecs(scope, cobraName string, decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		for i, value := range valueSpec.Values {
+			if i >= len(valueSpec.Names) {
+				break
+			}
+			if lit := cobraLiteral(value, cobraName); lit != nil {
+				s.define(scope+"."+valueSpec.Names[i].Name, lit)
+			}
+		}
+	}
+}
+
+func (s *cobraSource) define(key string, lit *ast.CompositeLit) {
+	cmd := &cobraCommand{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch key.Name {
+		case "Use":
+			cmd.use, _ = constString(kv.Value)
+		case "Short":
+			cmd.short, _ = constString(kv.Value)
+		case "Long":
+			cmd.long, _ = constString(kv.Value)
+		case "Hidden":
+			if ident, ok := kv.Value.(*ast.Ident); ok {
+				cmd.hidden = ident.Name == "true"
+			}
+		}
+	}
+	if _, exists := s.commands[key]; !exists {
+		s.order = append(s.order, key)
+	}
+	s.commands[key] = cmd
+}
+
+// scanCall records AddCommand, flag registrations and required flags
+func (s *cobraSource) scanCall(scope string, call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	switch sel.Sel.Name {
+	case "AddCommand":
+		receiver, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return
+		}
+		args := call.Args
+		s.calls = append(s.calls, cobraCall{scope: scope, receiver: receiver.Name, apply: func(cmd *cobraCommand, resolve func(string, ast.Expr) string) {
+			for _, arg := range args {
+				if child := resolve(scope, arg); child != "" {
+					cmd.children = append(cmd.children, child)
+				}
+			}
+		}})
+		return
+	case "MarkFlagRequired", "MarkPersistentFlagRequired":
+		receiver, ok := sel.X.(*ast.Ident)
+		name, isString := stringArg(call, 0)
+		if !ok || !isString {
+			return
+		}
+		s.calls = append(s.calls, cobraCall{scope: scope, receiver: receiver.Name, apply: func(cmd *cobraCommand, _ func(string, ast.Expr) string) {
+			for i := range cmd.flags {
+				if cmd.flags[i].Name == name {
+					cmd.flags[i].Required = true
+				}
+			}
+		}})
+		return
+	}
+
+	// cmd.Flags().StringP(...) or flags.StringP(...) after flags := cmd.Flags()
+	var receiver string
+	if name, ok := flagSetCall(sel.X); ok {
+		receiver = name
+	} else if ident, ok := sel.X.(*ast.Ident); ok {
+		receiver = "." + ident.Name // resolved through the flag set aliases
+	} else {
+		return
+	}
+
+	flag, ok := parseFlagRegistration(sel.Sel.Name, call.Args)
+	if !ok {
+		return
+	}
+	s.calls = append(s.calls, cobraCall{scope: scope, receiver: receiver, apply: func(cmd *cobraCommand, _ func(string, ast.Expr) string) {
+		cmd.flags = append(cmd.flags, flag)
+	}})
+}
+
+// resolve applies the recorded calls and flattens the command tree
+func (s *cobraSource) resolve() []models.Command {
+	lookup := func(key string) string {
+		for range 4 { // follow a short chain of aliases
+			if _, ok := s.commands[key]; ok {
+				return key
+			}
+			alias, ok := s.aliases[key]
+			if !ok {
+				return ""
+			}
+			key = alias
+		}
+		return ""
+	}
+	resolveName := func(scope, name string) string {
+		if key := lookup(scope + "." + name); key != "" {
+			return key
+		}
+		pkg := scope[:strings.LastIndex(scope, ".")]
+		return lookup(pkg + "." + name)
+	}
+	resolveExpr := func(scope string, expr ast.Expr) string {
+		switch expr := expr.(type) {
+		case *ast.Ident:
+			return resolveName(scope, expr.Name)
+		case *ast.CallExpr:
+			if fun, ok := expr.Fun.(*ast.Ident); ok {
+				pkg := scope[:strings.LastIndex(scope, ".")]
+				return lookup(pkg + "." + fun.Name + "()")
+			}
+		}
+		return ""
+	}
+
+	for _, call := range s.calls {
+		receiver := call.receiver
+		if flagSet, ok := strings.CutPrefix(receiver, "."); ok {
+			var found bool
+			receiver, found = s.aliases[call.scope+"."+flagSet+".flags"]
+			if !found {
+				continue
+			}
+		}
+		if key := resolveName(call.scope, receiver); key != "" {
+			call.apply(s.commands[key], resolveExpr)
+		}
+	}
+	for _, key := range s.order {
+		for _, child := range s.commands[key].children {
+			s.commands[child].isChild = true
+		}
+	}
+
+	var commands []models.Command
+	var visit func(key string, path []string, seen map[string]bool)
+	visit = func(key string, path []string, seen map[string]bool) {
+		cmd := s.commands[key]
+		if cmd.hidden || seen[key] {
+			return
+		}
+		seen[key] = true
+
+		// subcommands are named by their path below the root
+		childPath := path
+		if path == nil {
+			if len(cmd.flags) > 0 || len(cmd.children) == 0 {
+				commands = append(commands, cmd.model(commandName(cmd.use)))
+			}
+			childPath = []string{}
+		} else {
+			childPath = append(slices.Clip(path), commandName(cmd.use))
+			commands = append(commands, cmd.model(strings.Join(childPath, " ")))
+		}
+
+		for _, child := range cmd.children {
+			visit(child, childPath, seen)
+		}
+	}
+	for _, key := range s.order {
+		if !s.commands[key].isChild {
+			visit(key, nil, make(map[string]bool))
+		}
+	}
+	return commands
+}
+
+func (c *cobraCommand) model(name string) models.Command {
+	description := c.short
+	if description == "" {
+		description = strings.Join(strings.Fields(c.long), " ")
+	}
+	return models.Command{
+		Name:        name,
+		Description: description,
+		Flags:       c.flags,
+	}
+}
+
+// commandName is the first word of a cobra Use line
+func commandName(use string) string {
+	if fields := strings.Fields(use); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// cobraLiteral matches &cobra.Command{...} and cobra.Command{...}
+func cobraLiteral(expr ast.Expr, cobraName string) *ast.CompositeLit {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Command" {
+		return nil
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != cobraName {
+		return nil
+	}
+	return lit
+}
+
+// flagSetCall matches cmd.Flags(), cmd.LocalFlags() and cmd.PersistentFlags()
+func flagSetCall(expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	receiver, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	switch sel.Sel.Name {
+	case "Flags", "LocalFlags", "PersistentFlags":
+		return receiver.Name, true
+	}
+	return "", false
+}
+
+// parseFlagRegistration reads the arguments of pflag methods such as
+// String(name, default, usage) or BoolVarP(&v, name, short, default, usage)
+func parseFlagRegistration(method string, args []ast.Expr) (models.Flag, bool) {
+	parts := flagMethodPattern.FindStringSubmatch(method)
+	if parts == nil {
+		return models.Flag{}, false
+	}
+	kind, hasPointer, hasShort := parts[1], parts[2] != "", parts[3] != ""
+	if kind == "Var" { // Var(value, name, usage) takes a flag.Value
+		kind, hasPointer = "", true
+	}
+	hasDefault := kind != "" && kind != "Count"
+
+	i := 0
+	next := func() ast.Expr {
+		if i >= len(args) {
+			return nil
+		}
+		i++
+		return args[i-1]
+	}
+
+	if hasPointer {
+		next()
+	}
+	var flag models.Flag
+	var ok bool
+	if flag.Name, ok = exprString(next()); !ok || flag.Name == "" {
+		return models.Flag{}, false
+	}
+	if hasShort {
+		if flag.Short, ok = exprString(next()); !ok {
+			return models.Flag{}, false
+		}
+	}
+	if hasDefault {
+		flag.Default = defaultValue(next())
+	}
+	// usage built at runtime is left for the user to fill in
+	usage := next()
+	if usage == nil || i != len(args) {
+		return models.Flag{}, false
+	}
+	flag.Description, _ = constString(usage)
+	return flag, true
+}
+
+// durationUnits are the time constants flag defaults are written in
+var durationUnits = map[string]time.Duration{
+	"Nanosecond":  time.Nanosecond,
+	"Microsecond": time.Microsecond,
+	"Millisecond": time.Millisecond,
+	"Second":      time.Second,
+	"Minute":      time.Minute,
+	"Hour":        time.Hour,
+}
+
+// defaultValue renders a flag default, leaving zero values empty. Only
+// literals and what folds to a constant are rendered: a variable or
+// constant the default names, such as spec.DefaultPath, isn't known.
+func defaultValue(expr ast.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	if value, ok := constString(expr); ok {
+		return value
+	}
+	value, duration, ok := constValue(expr)
+	if !ok {
+		return ""
+	}
+	if value.Kind() == constant.Bool {
+		if constant.BoolVal(value) {
+			return "true"
+		}
+		return ""
+	}
+	if constant.Sign(value) == 0 {
+		return ""
+	}
+	if duration {
+		if ns, exact := constant.Int64Val(constant.ToInt(value)); exact {
+			return time.Duration(ns).String() // 30*time.Second gives 30s
+		}
+	}
+	return value.String()
+}
+
+// constValue folds number and bool literals and the arithmetic between
+// them, reporting whether a time unit such as time.Second was used
+func constValue(expr ast.Expr) (value constant.Value, duration, ok bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.INT && expr.Kind != token.FLOAT {
+			return nil, false, false
+		}
+		value = constant.MakeFromLiteral(expr.Value, expr.Kind, 0)
+		return value, false, value.Kind() != constant.Unknown
+	case *ast.Ident:
+		if expr.Name == "true" || expr.Name == "false" {
+			return constant.MakeBool(expr.Name == "true"), false, true
+		}
+	case *ast.SelectorExpr:
+		if pkg, isIdent := expr.X.(*ast.Ident); isIdent && pkg.Name == "time" {
+			if unit, isUnit := durationUnits[expr.Sel.Name]; isUnit {
+				return constant.MakeInt64(int64(unit)), true, true
+			}
+		}
+	case *ast.ParenExpr:
+		return constValue(expr.X)
+	case *ast.UnaryExpr:
+		if expr.Op != token.SUB && expr.Op != token.ADD {
+			return nil, false, false
+		}
+		x, duration, ok := constValue(expr.X)
+		if !ok || x.Kind() == constant.Bool {
+			return nil, false, false
+		}
+		return constant.UnaryOp(expr.Op, x, 0), duration, true
+	case *ast.BinaryExpr:
+		x, xDuration, ok := constValue(expr.X)
+		if !ok || x.Kind() == constant.Bool {
+			return nil, false, false
+		}
+		y, yDuration, ok := constValue(expr.Y)
+		if !ok || y.Kind() == constant.Bool {
+			return nil, false, false
+		}
+		op := expr.Op
+		switch op {
+		case token.ADD, token.SUB, token.MUL:
+		case token.QUO:
+			if constant.Sign(y) == 0 {
+				return nil, false, false
+			}
+			if x.Kind() == constant.Int && y.Kind() == constant.Int {
+				op = token.QUO_ASSIGN // integer division
+			}
+		default:
+			return nil, false, false
+		}
+		return constant.BinaryOp(x, op, y), xDuration || yDuration, true
+	}
+	return nil, false, false
+}
+
+func exprString(expr ast.Expr) (string, bool) {
+	if expr == nil {
+		return "", false
+	}
+	return constString(expr)
+}
+
+// constString evaluates string literals and their concatenations
+func constString(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		return stringLit(expr)
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD {
+			return "", false
+		}
+		left, ok := constString(expr.X)
+		if !ok {
+			return "", false
+		}
+		right, ok := constString(expr.Y)
+		return left + right, ok
+	case *ast.ParenExpr:
+		return constString(expr.X)
+	}
+	return "", false
+}
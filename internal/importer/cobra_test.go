@@ -0,0 +1,123 @@
+package importer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestCobraCommands(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "cmd", "root.go"), `package cmd
+
+import "github.com/spf13/cobra"
+
+var rootCmd = &cobra.Command{
+	Use:   "mytool",
+	Short: "My tool does things",
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Print more output")
+	rootCmd.AddCommand(serveCmd, newUserCmd())
+}
+`)
+	writeFile(t, filepath.Join(dir, "cmd", "serve.go"), `package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var port int
+
+var serveCmd = &cobra.Command{
+	Use:   "serve [dir]",
+	Short: "Serve the current " + "directory",
+}
+
+var debugCmd = &cobra.Command{Use: "debug", Short: "Hidden debugging", Hidden: true}
+
+func init() {
+	serveCmd.Flags().IntVarP(&port, "port", "p", 8080, "Port to listen on")
+	serveCmd.Flags().Duration("timeout", 30*time.Second, "Request timeout")
+	serveCmd.Flags().String("cert", "", "TLS certificate file")
+	serveCmd.Flags().String("root", config.DefaultRoot, "Directory to serve")
+	serveCmd.Flags().Float64("ratio", 1.0/4, "Compression ratio")
+	serveCmd.MarkFlagRequired("cert")
+	serveCmd.AddCommand(debugCmd)
+}
+`)
+	writeFile(t, filepath.Join(dir, "cmd", "user.go"), `package cmd
+
+import cc "github.com/spf13/cobra"
+
+func newUserCmd() *cc.Command {
+	cmd := &cc.Command{Use: "user", Short: "Manage users"}
+	cmd.AddCommand(newUserAddCmd())
+	return cmd
+}
+
+func newUserAddCmd() *cc.Command {
+	cmd := &cc.Command{Use: "add NAME", Long: "Add a user\nto the database"}
+	flags := cmd.Flags()
+	flags.StringSlice("roles", []string{}, "Roles to grant")
+	return cmd
+}
+`)
+
+	commands, err := CobraCommands(dir)
+	if err != nil {
+		t.Fatalf("CobraCommands failed: %v", err)
+	}
+
+	want := []models.Command{
+		{Name: "mytool", Description: "My tool does things", Flags: []models.Flag{
+			{Name: "verbose", Short: "v", Description: "Print more output"},
+		}},
+		{Name: "serve", Description: "Serve the current directory", Flags: []models.Flag{
+			{Name: "port", Short: "p", Default: "8080", Description: "Port to listen on"},
+			{Name: "timeout", Default: "30s", Description: "Request timeout"},
+			{Name: "cert", Description: "TLS certificate file", Required: true},
+			{Name: "root", Description: "Directory to serve"},
+			{Name: "ratio", Default: "0.25", Description: "Compression ratio"},
+		}},
+		{Name: "user", Description: "Manage users"},
+		{Name: "user add", Description: "Add a user to the database", Flags: []models.Flag{
+			{Name: "roles", Description: "Roles to grant"},
+		}},
+	}
+
+	if len(commands) != len(want) {
+		t.Fatalf("expected %d commands, got %+v", len(want), commands)
+	}
+	for i, command := range commands {
+		if command.Name != want[i].Name || command.Description != want[i].Description {
+			t.Errorf("command %d: expected %q (%q), got %q (%q)", i, want[i].Name, want[i].Description, command.Name, command.Description)
+		}
+		if len(command.Flags) != len(want[i].Flags) {
+			t.Errorf("command %q: expected flags %+v, got %+v", command.Name, want[i].Flags, command.Flags)
+			continue
+		}
+		for j, flag := range command.Flags {
+			if flag != want[i].Flags[j] {
+				t.Errorf("command %q flag %d: expected %+v, got %+v", command.Name, j, want[i].Flags[j], flag)
+			}
+		}
+	}
+}
+
+func TestCobraCommands_NoCobra(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "main.go"), "package main\n\nfunc main() {}\n")
+
+	commands, err := CobraCommands(dir)
+	if err != nil {
+		t.Fatalf("CobraCommands failed: %v", err)
+	}
+	if len(commands) != 0 {
+		t.Errorf("expected no commands, got %+v", commands)
+	}
+}
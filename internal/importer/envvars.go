@@ -0,0 +1,396 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// EnvFiles are the example env files looked for in a project, in order
+var EnvFiles = []string{".env.example", ".env.sample", ".env.template"}
+
+// envValuePattern matches what looks like a value: a quoted string, a
+// number, a :port, a path, a URL or a boolean
+const envValuePattern = `"[^"]*"|'[^']*'|[0-9][^\s,;)]*|:[0-9][^\s,;)]*|/[\w.~-][^\s,;)]*|[a-z][a-z0-9+.-]*://[^\s,;)]*|(?:true|false)\b`
+
+var (
+	envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// majorVersionPattern matches the /v2 style suffix of module paths
+	majorVersionPattern = regexp.MustCompile(`^v[0-9]+$`)
+	// envDefaultPattern matches "default 8080", "defaults to :8080",
+	// "default is 'info'" and "default: redis://...", but not prose such
+	// as "use default settings": without a "to", "is", ":" or "=" the
+	// value has to look like one, and with one it can be a bare word
+	envDefaultPattern = regexp.MustCompile(`(?i)\bdefaults?(?:(?:\s+(?:to|is)\b|\s*[:=])\s*(` + envValuePattern + `|[a-z](?:[\w.-]*\w)?)|\s+(` + envValuePattern + `))`)
+)
+
+// EnvVars proposes environment variables for the project in dir from its
+// example env files and the os.Getenv/os.LookupEnv calls in its Go source.
+// Entries may be incomplete and are meant to be confirmed by the user.
+func EnvVars(dir string) ([]models.EnvVar, error) {
+	var envVars []models.EnvVar
+	for _, name := range EnvFiles {
+		fileVars, err := ParseEnvFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		envVars = mergeEnvVars(envVars, fileVars)
+	}
+
+	sourceVars, err := ScanGoEnvVars(dir)
+	if err != nil {
+		return nil, err
+	}
+	return mergeEnvVars(envVars, sourceVars), nil
+}
+
+// ParseEnvFile reads a dotenv style file. Comment lines directly above a
+// variable become its description and its value becomes the example.
+// Variables described as optional, or with a default in their comment,
+// are not required.
+func ParseEnvFile(path string) ([]models.EnvVar, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var (
+		envVars []models.EnvVar
+		comment []string
+	)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			comment = nil // blank lines separate comments from variables
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			comment = append(comment, strings.TrimSpace(strings.TrimLeft(line, "#")))
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+		name, value, found := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !found || !envNamePattern.MatchString(name) {
+			comment = nil
+			continue
+		}
+
+		value, inline := splitEnvValue(strings.TrimSpace(value))
+		if inline != "" {
+			comment = append(comment, inline)
+		}
+		description := strings.Join(comment, " ")
+		comment = nil
+
+		envVar := models.EnvVar{
+			Name:        name,
+			Description: description,
+			Example:     value,
+		}
+		envVar.Default = envDefault(description)
+		envVar.Required = envVar.Default == "" && !strings.Contains(strings.ToLower(description), "optional")
+		envVars = append(envVars, envVar)
+	}
+	return envVars, scanner.Err()
+}
+
+// envDefault finds the default value a description mentions
+func envDefault(description string) string {
+	match := envDefaultPattern.FindStringSubmatch(description)
+	if match == nil {
+		return ""
+	}
+	value := match[1] + match[2]
+	switch strings.ToLower(value) {
+	case "a", "an", "the": // "defaults to the first free port"
+		return ""
+	}
+	return strings.Trim(value, `"'`)
+}
+
+// splitEnvValue unquotes a dotenv value and separates any inline comment
+func splitEnvValue(value string) (string, string) {
+	if len(value) > 0 && (value[0] == '"' || value[0] == '\'') {
+		if end := strings.IndexByte(value[1:], value[0]); end >= 0 {
+			rest := strings.TrimSpace(value[end+2:])
+			return value[1 : end+1], strings.TrimSpace(strings.TrimPrefix(rest, "#"))
+		}
+	}
+	if i := strings.Index(value, " #"); i >= 0 {
+		return strings.TrimSpace(value[:i]), strings.TrimSpace(value[i+2:])
+	}
+	return value, ""
+}
+
+// ScanGoEnvVars finds the environment variables read by the Go source under
+// dir. It recognises os.Getenv and os.LookupEnv, helpers called like
+// getEnv("NAME", "default"), and the common fallback pattern:
+//
+//	port := os.Getenv("PORT")
+//	if port == "" {
+//		port = "8080"
+//	}
+//
+// The comment on the line above a call becomes the description.
+func ScanGoEnvVars(dir string) ([]models.EnvVar, error) {
+	var envVars []models.EnvVar
+	err := walkGoFiles(dir, func(fset *token.FileSet, file *ast.File) {
+		envVars = mergeEnvVars(envVars, scanFileEnvVars(fset, file))
+	})
+	return envVars, err
+}
+
+func scanFileEnvVars(fset *token.FileSet, file *ast.File) []models.EnvVar {
+	osName := importName(file, "os")
+	comments := commentsByEndLine(fset, file)
+
+	var envVars []models.EnvVar
+	add := func(call *ast.CallExpr, name, defaultValue string) {
+		envVar := models.EnvVar{
+			Name:        name,
+			Description: comments[fset.Position(call.Pos()).Line-1],
+			Default:     defaultValue,
+			Example:     defaultValue,
+			Required:    defaultValue == "",
+		}
+		envVars = mergeEnvVars(envVars, []models.EnvVar{envVar})
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			// variables assigned from os.Getenv and then defaulted
+			for i, stmt := range n.List {
+				call, variable := getenvAssignment(stmt, osName)
+				if call == nil {
+					continue
+				}
+				name, _ := stringArg(call, 0)
+				defaultValue := ""
+				if i+1 < len(n.List) {
+					defaultValue = fallbackValue(n.List[i+1], variable)
+				}
+				add(call, name, defaultValue)
+			}
+		case *ast.CallExpr:
+			if name, ok := envCall(n, osName); ok {
+				add(n, name, "")
+			} else if name, defaultValue, ok := envHelperCall(n); ok {
+				add(n, name, defaultValue)
+			}
+		}
+		return true
+	})
+	return envVars
+}
+
+// envCall matches os.Getenv("NAME") and os.LookupEnv("NAME")
+func envCall(call *ast.CallExpr, osName string) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Getenv" && sel.Sel.Name != "LookupEnv") {
+		return "", false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || osName == "" || pkg.Name != osName {
+		return "", false
+	}
+	return stringArg(call, 0)
+}
+
+// envHelperCall matches helpers such as getEnv("NAME", "default") whose
+// name mentions env and whose arguments are a variable name and a default
+func envHelperCall(call *ast.CallExpr) (string, string, bool) {
+	var funcName string
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		funcName = fun.Name
+	case *ast.SelectorExpr:
+		funcName = fun.Sel.Name
+	}
+	if len(call.Args) != 2 || !strings.Contains(strings.ToLower(funcName), "env") {
+		return "", "", false
+	}
+
+	name, ok := stringArg(call, 0)
+	if !ok || name != strings.ToUpper(name) || !envNamePattern.MatchString(name) {
+		return "", "", false
+	}
+	defaultValue, ok := stringArg(call, 1)
+	return name, defaultValue, ok
+}
+
+// getenvAssignment matches `v := os.Getenv("NAME")` and `v = os.Getenv(...)`
+func getenvAssignment(stmt ast.Stmt, osName string) (*ast.CallExpr, string) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, ""
+	}
+	variable, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return nil, ""
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok {
+		return nil, ""
+	}
+	if _, ok := envCall(call, osName); !ok {
+		return nil, ""
+	}
+	return call, variable.Name
+}
+
+// fallbackValue matches `if v == "" { v = "default" }`
+func fallbackValue(stmt ast.Stmt, variable string) string {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || len(ifStmt.Body.List) != 1 {
+		return ""
+	}
+	cond, ok := ifStmt.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.EQL {
+		return ""
+	}
+	if ident, ok := cond.X.(*ast.Ident); !ok || ident.Name != variable {
+		return ""
+	}
+	if empty, ok := stringLit(cond.Y); !ok || empty != "" {
+		return ""
+	}
+
+	assign, ok := ifStmt.Body.List[0].(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return ""
+	}
+	if ident, ok := assign.Lhs[0].(*ast.Ident); !ok || ident.Name != variable {
+		return ""
+	}
+	value, _ := stringLit(assign.Rhs[0])
+	return value
+}
+
+// mergeEnvVars adds the variables in more that aren't in envVars yet and
+// uses them to fill in missing details of the ones that are
+func mergeEnvVars(envVars, more []models.EnvVar) []models.EnvVar {
+	for _, envVar := range more {
+		i := indexEnvVar(envVars, envVar.Name)
+		if i < 0 {
+			envVars = append(envVars, envVar)
+			continue
+		}
+		existing := &envVars[i]
+		if existing.Description == "" {
+			existing.Description = envVar.Description
+		}
+		if existing.Example == "" {
+			existing.Example = envVar.Example
+		}
+		if existing.Default == "" && envVar.Default != "" {
+			existing.Default = envVar.Default
+			existing.Required = false
+		}
+	}
+	return envVars
+}
+
+func indexEnvVar(envVars []models.EnvVar, name string) int {
+	for i, envVar := range envVars {
+		if envVar.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// walkGoFiles parses every non-test Go file under dir, skipping vendored,
+// hidden and testdata directories. Files that don't parse are skipped.
+func walkGoFiles(dir string, visit func(*token.FileSet, *ast.File)) error {
+	fset := token.NewFileSet()
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != dir && (name == "vendor" || name == "testdata" || name == "node_modules" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil
+		}
+		visit(fset, file)
+		return nil
+	})
+}
+
+// importName returns the name a file uses for an imported package, or ""
+// when the package isn't imported
+func importName(file *ast.File, path string) string {
+	for _, imp := range file.Imports {
+		if importPath, _ := strconv.Unquote(imp.Path.Value); importPath != path {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return defaultImportName(path)
+	}
+	return ""
+}
+
+// defaultImportName guesses the package name of an import path: its last
+// element, skipping a major version suffix such as /v5
+func defaultImportName(path string) string {
+	elements := strings.Split(path, "/")
+	name := elements[len(elements)-1]
+	if len(elements) > 1 && majorVersionPattern.MatchString(name) {
+		name = elements[len(elements)-2]
+	}
+	return name
+}
+
+// commentsByEndLine indexes a file's comments by the line they end on
+func commentsByEndLine(fset *token.FileSet, file *ast.File) map[int]string {
+	comments := make(map[int]string)
+	for _, group := range file.Comments {
+		comments[fset.Position(group.End()).Line] = strings.Join(strings.Fields(group.Text()), " ")
+	}
+	return comments
+}
+
+func stringArg(call *ast.CallExpr, i int) (string, bool) {
+	if i >= len(call.Args) {
+		return "", false
+	}
+	return stringLit(call.Args[i])
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	return value, err == nil
+}
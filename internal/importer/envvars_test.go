@@ -0,0 +1,177 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func TestParseEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env.example")
+	writeFile(t, path, `# Postgres connection string
+DATABASE_URL=postgres://localhost:5432/app
+
+# HTTP port, default 8080
+export PORT=8080
+LOG_LEVEL="info" # optional log level
+
+not a variable
+`)
+
+	envVars, err := ParseEnvFile(path)
+	if err != nil {
+		t.Fatalf("ParseEnvFile failed: %v", err)
+	}
+
+	want := []models.EnvVar{
+		{Name: "DATABASE_URL", Description: "Postgres connection string", Example: "postgres://localhost:5432/app", Required: true},
+		{Name: "PORT", Description: "HTTP port, default 8080", Example: "8080", Default: "8080"},
+		{Name: "LOG_LEVEL", Description: "optional log level", Example: "info"},
+	}
+	if len(envVars) != len(want) {
+		t.Fatalf("expected %d variables, got %+v", len(want), envVars)
+	}
+	for i := range want {
+		if envVars[i] != want[i] {
+			t.Errorf("variable %d: expected %+v, got %+v", i, want[i], envVars[i])
+		}
+	}
+}
+
+func TestEnvDefault(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+		required    bool
+	}{
+		{"HTTP port, default 8080", "8080", false},
+		{"Port (defaults to 8080)", "8080", false},
+		{"listen address, defaults to :8080", ":8080", false},
+		{"Default is 3", "3", false},
+		{"log level, default is 'info'", "info", false},
+		{"default: redis://localhost:6379", "redis://localhost:6379", false},
+		{"cache dir (default=/tmp/cache)", "/tmp/cache", false},
+		{"verbose output, defaults to false", "false", false},
+		{"Log level (default: debug)", "debug", false},
+		{"Log level, defaults to info", "info", false},
+		{"Environment; the default is production.", "production", false},
+		{"Region (default us-east-1)", "", true},
+		{"Default value is 3", "", true},
+		{"Use default settings", "", true},
+		{"overrides the defaults", "", true},
+		{"Port (defaults to the first free port)", "", true},
+		{"Optional, default: / is not a path", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := envDefault(tt.description); got != tt.want {
+				t.Errorf("envDefault(%q) = %q, want %q", tt.description, got, tt.want)
+			}
+
+			path := filepath.Join(t.TempDir(), ".env.example")
+			writeFile(t, path, "# "+tt.description+"\nVALUE=x\n")
+			envVars, err := ParseEnvFile(path)
+			if err != nil {
+				t.Fatalf("ParseEnvFile failed: %v", err)
+			}
+			if len(envVars) != 1 || envVars[0].Default != tt.want || envVars[0].Required != tt.required {
+				t.Errorf("expected default %q and required %v, got %+v", tt.want, tt.required, envVars)
+			}
+		})
+	}
+}
+
+func TestScanGoEnvVars(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "main.go"), `package main
+
+import goos "os"
+
+func main() {
+	// address to listen on
+	addr := goos.Getenv("ADDR")
+	if addr == "" {
+		addr = ":8080"
+	}
+
+	// secret used to sign tokens
+	if _, ok := goos.LookupEnv("JWT_SECRET"); !ok {
+		panic("JWT_SECRET is required")
+	}
+
+	_ = getEnv("REDIS_URL", "redis://localhost:6379")
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := goos.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+`)
+	writeFile(t, filepath.Join(dir, "main_test.go"), "package main\n\nimport \"os\"\n\nvar _ = os.Getenv(\"TEST_ONLY\")\n")
+	writeFile(t, filepath.Join(dir, "vendor", "dep", "dep.go"), "package dep\n\nimport \"os\"\n\nvar _ = os.Getenv(\"VENDORED\")\n")
+
+	envVars, err := ScanGoEnvVars(dir)
+	if err != nil {
+		t.Fatalf("ScanGoEnvVars failed: %v", err)
+	}
+
+	want := []models.EnvVar{
+		{Name: "ADDR", Description: "address to listen on", Default: ":8080", Example: ":8080"},
+		{Name: "JWT_SECRET", Description: "secret used to sign tokens", Required: true},
+		{Name: "REDIS_URL", Default: "redis://localhost:6379", Example: "redis://localhost:6379"},
+	}
+	if len(envVars) != len(want) {
+		t.Fatalf("expected %d variables, got %+v", len(want), envVars)
+	}
+	for i := range want {
+		if envVars[i] != want[i] {
+			t.Errorf("variable %d: expected %+v, got %+v", i, want[i], envVars[i])
+		}
+	}
+}
+
+func TestEnvVars_MergesSources(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ".env.example"), "# listen address\nADDR=:9000\n")
+	writeFile(t, filepath.Join(dir, "main.go"), `package main
+
+import "os"
+
+func main() {
+	addr := os.Getenv("ADDR")
+	if addr == "" {
+		addr = ":8080"
+	}
+	_ = os.Getenv("DEBUG")
+}
+`)
+
+	envVars, err := EnvVars(dir)
+	if err != nil {
+		t.Fatalf("EnvVars failed: %v", err)
+	}
+	if len(envVars) != 2 {
+		t.Fatalf("expected 2 variables, got %+v", envVars)
+	}
+	addr := envVars[0]
+	if addr.Example != ":9000" || addr.Default != ":8080" || addr.Required {
+		t.Errorf("expected env file example with source default, got %+v", addr)
+	}
+	if envVars[1].Name != "DEBUG" {
+		t.Errorf("expected DEBUG from source, got %+v", envVars[1])
+	}
+}
@@ -0,0 +1,674 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// GraphQL description limits, matching the models
+const (
+	maxGraphQLDescription      = 500
+	maxGraphQLFieldDescription = 200
+)
+
+// ImportGraphQL reads a GraphQL schema written in SDL, possibly split over
+// several files as gqlgen projects do. It documents the fields of the
+// query, mutation and subscription types with their arguments, and the
+// types those operations use. The endpoint is left for the caller.
+func ImportGraphQL(paths ...string) (*models.GraphQLDocs, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no schema files given")
+	}
+
+	schema := &sdlSchema{types: map[string]*sdlDefinition{}, roots: map[string]string{}}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := schema.parse(string(data)); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	docs := schema.docs()
+	if len(docs.Queries)+len(docs.Mutations)+len(docs.Subscriptions) == 0 {
+		return nil, fmt.Errorf("%s: no query, mutation or subscription fields found", strings.Join(paths, ", "))
+	}
+
+	docs.Schema = paths[0]
+	if len(paths) > 1 {
+		docs.Schema = filepath.Dir(paths[0])
+	}
+	return docs, nil
+}
+
+// sdlSchema collects the definitions of one or more SDL files
+type sdlSchema struct {
+	types map[string]*sdlDefinition
+	order []string          // type names in the order they were defined
+	roots map[string]string // operation type (query, ...) to type name
+}
+
+// sdlDefinition is a named type definition
+type sdlDefinition struct {
+	kind        string // type, input, enum, interface, union or scalar
+	name        string
+	description string
+	fields      []sdlField
+	values      []string // enum values or union members
+}
+
+type sdlField struct {
+	name        string
+	description string
+	typ         string
+	deprecated  string
+	arguments   []models.GraphQLArgument
+}
+
+// define records a definition, merging it into an earlier one with the
+// same name as extend does
+func (s *sdlSchema) define(definition *sdlDefinition) {
+	existing, ok := s.types[definition.name]
+	if !ok {
+		s.types[definition.name] = definition
+		s.order = append(s.order, definition.name)
+		return
+	}
+	if existing.description == "" {
+		existing.description = definition.description
+	}
+	existing.fields = append(existing.fields, definition.fields...)
+	existing.values = append(existing.values, definition.values...)
+}
+
+// rootType names the type holding an operation type's fields
+func (s *sdlSchema) rootType(operation string) string {
+	if name, ok := s.roots[operation]; ok {
+		return name
+	}
+	return strings.ToUpper(operation[:1]) + operation[1:]
+}
+
+// docs turns the schema into the GraphQL model, keeping only the types
+// reachable from the operations
+func (s *sdlSchema) docs() *models.GraphQLDocs {
+	docs := &models.GraphQLDocs{}
+	reachable := map[string]bool{}
+	var visit func(reference string)
+	visit = func(reference string) {
+		name := namedType(reference)
+		definition, ok := s.types[name]
+		if !ok || reachable[name] {
+			return
+		}
+		reachable[name] = true
+		for _, field := range definition.fields {
+			visit(field.typ)
+			for _, argument := range field.arguments {
+				visit(argument.Type)
+			}
+		}
+		if definition.kind == "union" {
+			for _, member := range definition.values {
+				visit(member)
+			}
+		}
+	}
+
+	roots := map[string]bool{}
+	for _, operation := range []struct {
+		name string
+		list *[]models.GraphQLOperation
+	}{
+		{"query", &docs.Queries},
+		{"mutation", &docs.Mutations},
+		{"subscription", &docs.Subscriptions},
+	} {
+		definition, ok := s.types[s.rootType(operation.name)]
+		if !ok {
+			continue
+		}
+		roots[definition.name] = true
+		for _, field := range definition.fields {
+			*operation.list = append(*operation.list, models.GraphQLOperation{
+				Name:        field.name,
+				Description: truncate(field.description, maxGraphQLDescription),
+				Arguments:   field.arguments,
+				Type:        field.typ,
+				Deprecated:  truncate(field.deprecated, maxGraphQLFieldDescription),
+			})
+			visit(field.typ)
+			for _, argument := range field.arguments {
+				visit(argument.Type)
+			}
+		}
+	}
+
+	for _, name := range s.order {
+		definition := s.types[name]
+		if !reachable[name] || roots[name] {
+			continue
+		}
+		graphQLType := models.GraphQLType{
+			Name:        definition.name,
+			Kind:        definition.kind,
+			Description: truncate(definition.description, maxGraphQLDescription),
+			Values:      definition.values,
+		}
+		for _, field := range definition.fields {
+			graphQLType.Fields = append(graphQLType.Fields, models.GraphQLField{
+				Name:        field.name,
+				Type:        field.typ,
+				Description: truncate(field.description, maxGraphQLFieldDescription),
+			})
+		}
+		docs.Types = append(docs.Types, graphQLType)
+	}
+	return docs
+}
+
+// namedType strips list and non-null wrappers from a type reference
+func namedType(reference string) string {
+	return strings.Trim(reference, "[]! ")
+}
+
+// sdlParser is a recursive descent parser over SDL tokens
+type sdlParser struct {
+	src    string
+	tokens []sdlToken
+	pos    int
+	schema *sdlSchema
+}
+
+func (s *sdlSchema) parse(src string) error {
+	tokens, err := lexSDL(src)
+	if err != nil {
+		return err
+	}
+	p := &sdlParser{src: src, tokens: tokens, schema: s}
+	for p.peek().kind != sdlEOF {
+		if err := p.definition(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *sdlParser) definition() error {
+	description := p.description()
+	keyword, err := p.name()
+	if err != nil {
+		return err
+	}
+	if keyword == "extend" {
+		if keyword, err = p.name(); err != nil {
+			return err
+		}
+	}
+
+	definition := &sdlDefinition{kind: keyword, description: description}
+	switch keyword {
+	case "schema":
+		return p.schemaDefinition()
+	case "directive":
+		return p.directiveDefinition()
+	case "scalar", "type", "interface", "input", "enum", "union":
+	case "query", "mutation", "subscription", "fragment":
+		return p.errorf("found a %s operation, expected a schema definition", keyword)
+	default:
+		return p.errorf("unexpected %q", keyword)
+	}
+
+	if definition.name, err = p.name(); err != nil {
+		return err
+	}
+	if (keyword == "type" || keyword == "interface") && p.skipName("implements") {
+		p.skip("&")
+		for {
+			if _, err := p.name(); err != nil {
+				return err
+			}
+			if !p.skip("&") {
+				break
+			}
+		}
+	}
+	if _, err := p.directives(); err != nil {
+		return err
+	}
+
+	switch keyword {
+	case "type", "interface", "input":
+		if p.peekPunct("{") {
+			if definition.fields, err = p.fields(keyword == "input"); err != nil {
+				return err
+			}
+		}
+	case "enum":
+		if p.skip("{") {
+			for !p.skip("}") {
+				p.description()
+				value, err := p.name()
+				if err != nil {
+					return err
+				}
+				if _, err := p.directives(); err != nil {
+					return err
+				}
+				definition.values = append(definition.values, value)
+			}
+		}
+	case "union":
+		if p.skip("=") {
+			p.skip("|")
+			for {
+				member, err := p.name()
+				if err != nil {
+					return err
+				}
+				definition.values = append(definition.values, member)
+				if !p.skip("|") {
+					break
+				}
+			}
+		}
+	}
+
+	p.schema.define(definition)
+	return nil
+}
+
+// schemaDefinition reads which types hold the root operations
+func (p *sdlParser) schemaDefinition() error {
+	if _, err := p.directives(); err != nil {
+		return err
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.skip("}") {
+		operation, err := p.name()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		p.schema.roots[operation] = name
+	}
+	return nil
+}
+
+// directiveDefinition skips a directive declaration
+func (p *sdlParser) directiveDefinition() error {
+	if err := p.expect("@"); err != nil {
+		return err
+	}
+	if _, err := p.name(); err != nil {
+		return err
+	}
+	if p.peekPunct("(") {
+		if _, err := p.arguments(); err != nil {
+			return err
+		}
+	}
+	p.skipName("repeatable")
+	if !p.skipName("on") {
+		return p.errorf("expected on")
+	}
+	p.skip("|")
+	for {
+		if _, err := p.name(); err != nil {
+			return err
+		}
+		if !p.skip("|") {
+			return nil
+		}
+	}
+}
+
+// fields reads a { ... } block of fields, or of input values for inputs
+func (p *sdlParser) fields(input bool) ([]sdlField, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var fields []sdlField
+	for !p.skip("}") {
+		field := sdlField{description: p.description()}
+		var err error
+		if field.name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if !input && p.peekPunct("(") {
+			if field.arguments, err = p.arguments(); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if field.typ, err = p.typeRef(); err != nil {
+			return nil, err
+		}
+		if input && p.skip("=") {
+			if _, _, err := p.value(); err != nil {
+				return nil, err
+			}
+		}
+		directives, err := p.directives()
+		if err != nil {
+			return nil, err
+		}
+		if reason, ok := directives["deprecated"]; ok {
+			field.deprecated = firstNonEmpty(reason, "No longer supported")
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// arguments reads a ( ... ) list of argument definitions
+func (p *sdlParser) arguments() ([]models.GraphQLArgument, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var arguments []models.GraphQLArgument
+	for !p.skip(")") {
+		argument := models.GraphQLArgument{Description: truncate(p.description(), maxGraphQLFieldDescription)}
+		var err error
+		if argument.Name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if argument.Type, err = p.typeRef(); err != nil {
+			return nil, err
+		}
+		if p.skip("=") {
+			raw, _, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			argument.Default = truncate(raw, 100)
+		}
+		if _, err := p.directives(); err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, argument)
+	}
+	return arguments, nil
+}
+
+// directives reads @name(arg: value) annotations, returning the reason
+// argument of each one (empty when it has none)
+func (p *sdlParser) directives() (map[string]string, error) {
+	directives := map[string]string{}
+	for p.skip("@") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		directives[name] = ""
+		if !p.skip("(") {
+			continue
+		}
+		for !p.skip(")") {
+			argument, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			_, text, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			if argument == "reason" {
+				directives[name] = text
+			}
+		}
+	}
+	return directives, nil
+}
+
+// typeRef reads a type reference such as [Pet!]!
+func (p *sdlParser) typeRef() (string, error) {
+	var reference string
+	if p.skip("[") {
+		inner, err := p.typeRef()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect("]"); err != nil {
+			return "", err
+		}
+		reference = "[" + inner + "]"
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return "", err
+		}
+		reference = name
+	}
+	if p.skip("!") {
+		reference += "!"
+	}
+	return reference, nil
+}
+
+// value reads a constant value, returning its source text and, for
+// strings, the decoded text
+func (p *sdlParser) value() (raw, text string, err error) {
+	start := p.peek().start
+	token := p.next()
+	switch {
+	case token.kind == sdlString:
+		text = token.value
+	case token.kind == sdlName, token.kind == sdlNumber:
+	case token.is("$"):
+		if _, err := p.name(); err != nil {
+			return "", "", err
+		}
+	case token.is("["):
+		for !p.skip("]") {
+			if _, _, err := p.value(); err != nil {
+				return "", "", err
+			}
+		}
+	case token.is("{"):
+		for !p.skip("}") {
+			if _, err := p.name(); err != nil {
+				return "", "", err
+			}
+			if err := p.expect(":"); err != nil {
+				return "", "", err
+			}
+			if _, _, err := p.value(); err != nil {
+				return "", "", err
+			}
+		}
+	default:
+		return "", "", p.errorAt(token, "expected a value")
+	}
+	return flatten(p.src[start:p.tokens[p.pos-1].end]), text, nil
+}
+
+// description reads an optional description string before a definition
+func (p *sdlParser) description() string {
+	if p.peek().kind != sdlString {
+		return ""
+	}
+	return flatten(p.next().value)
+}
+
+func (p *sdlParser) peek() sdlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *sdlParser) next() sdlToken {
+	token := p.tokens[p.pos]
+	if token.kind != sdlEOF {
+		p.pos++
+	}
+	return token
+}
+
+func (p *sdlParser) peekPunct(punct string) bool {
+	return p.peek().is(punct)
+}
+
+// skip consumes the punctuator if it's next
+func (p *sdlParser) skip(punct string) bool {
+	if p.peekPunct(punct) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipName consumes the keyword if it's next
+func (p *sdlParser) skipName(keyword string) bool {
+	if token := p.peek(); token.kind == sdlName && token.value == keyword {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sdlParser) expect(punct string) error {
+	if !p.skip(punct) {
+		return p.errorf("expected %q", punct)
+	}
+	return nil
+}
+
+func (p *sdlParser) name() (string, error) {
+	token := p.peek()
+	if token.kind != sdlName {
+		return "", p.errorf("expected a name")
+	}
+	p.pos++
+	return token.value, nil
+}
+
+func (p *sdlParser) errorf(format string, args ...interface{}) error {
+	return p.errorAt(p.peek(), fmt.Sprintf(format, args...))
+}
+
+func (p *sdlParser) errorAt(token sdlToken, message string) error {
+	if token.kind == sdlEOF {
+		return fmt.Errorf("line %d: %s, got end of file", token.line, message)
+	}
+	return fmt.Errorf("line %d: %s, got %q", token.line, message, p.src[token.start:token.end])
+}
+
+type sdlTokenKind int
+
+const (
+	sdlEOF sdlTokenKind = iota
+	sdlName
+	sdlPunct
+	sdlString
+	sdlNumber
+)
+
+type sdlToken struct {
+	kind       sdlTokenKind
+	value      string // names, punctuators and decoded strings
+	start, end int    // byte offsets in the source
+	line       int
+}
+
+func (t sdlToken) is(punct string) bool {
+	return t.kind == sdlPunct && t.value == punct
+}
+
+// lexSDL splits SDL source into tokens, dropping whitespace, commas and
+// comments
+func lexSDL(src string) ([]sdlToken, error) {
+	var tokens []sdlToken
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(src[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, sdlToken{kind: sdlPunct, value: "...", start: i, end: i + 3, line: line})
+			i += 3
+		case strings.ContainsRune("!$&():=@[]{}|", rune(c)):
+			tokens = append(tokens, sdlToken{kind: sdlPunct, value: string(c), start: i, end: i + 1, line: line})
+			i++
+		case c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+			start := i
+			for i < len(src) && isNameChar(src[i]) {
+				i++
+			}
+			tokens = append(tokens, sdlToken{kind: sdlName, value: src[start:i], start: start, end: i, line: line})
+		case c == '-' || c >= '0' && c <= '9':
+			start := i
+			i++
+			for i < len(src) && (isNameChar(src[i]) || strings.ContainsRune(".+-", rune(src[i]))) {
+				i++
+			}
+			tokens = append(tokens, sdlToken{kind: sdlNumber, value: src[start:i], start: start, end: i, line: line})
+		case strings.HasPrefix(src[i:], `"""`):
+			end := strings.Index(strings.ReplaceAll(src[i+3:], `\"""`, "xxxx"), `"""`)
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated block string", line)
+			}
+			text := src[i+3 : i+3+end]
+			tokens = append(tokens, sdlToken{kind: sdlString, value: strings.ReplaceAll(text, `\"""`, `"""`), start: i, end: i + 6 + end, line: line})
+			line += strings.Count(text, "\n")
+			i += 6 + end
+		case c == '"':
+			end := i + 1
+			for end < len(src) && src[end] != '"' && src[end] != '\n' {
+				if src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(src) || src[end] != '"' {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			var text string
+			if err := json.Unmarshal([]byte(src[i:end+1]), &text); err != nil {
+				return nil, fmt.Errorf("line %d: invalid string %s", line, src[i:end+1])
+			}
+			tokens = append(tokens, sdlToken{kind: sdlString, value: text, start: i, end: end + 1, line: line})
+			i = end + 1
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return append(tokens, sdlToken{kind: sdlEOF, start: len(src), end: len(src), line: line}), nil
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
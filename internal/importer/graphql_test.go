@@ -0,0 +1,179 @@
+package importer
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+const petstoreSDL = `# Pet store schema
+schema {
+  query: Root
+  mutation: Mutation
+}
+
+directive @auth(requires: Role = ADMIN) on OBJECT | FIELD_DEFINITION
+
+"""
+Entry point for reads.
+"""
+type Root {
+  "Look up a pet by its ID"
+  pet(id: ID!): Pet
+  """
+  List pets, newest first
+  """
+  pets(
+    "Only pets of this kind"
+    kind: Kind
+    first: Int = 10, after: String
+    filter: PetFilter = {tags: ["cute"], minAge: 1}
+  ): [Pet!]!
+  oldPets: [Pet] @deprecated(reason: "Use pets with a filter")
+}
+
+type Mutation {
+  addPet(input: NewPet!): Pet! @auth
+}
+
+"A pet in the store"
+type Pet implements Node & Named @key(fields: "id") {
+  id: ID!
+  name: String!
+  kind: Kind!
+  owner: Owner
+  born: Time
+}
+
+interface Node {
+  id: ID!
+}
+
+union Owner = Person | Shelter
+
+type Person { name: String }
+type Shelter { address: String }
+
+enum Kind {
+  "Barks"
+  DOG
+  CAT @deprecated
+}
+
+input NewPet {
+  name: String!
+  kind: Kind = DOG
+}
+
+input PetFilter {
+  tags: [String!]
+  minAge: Int
+}
+
+scalar Time
+
+type Unused { id: ID }
+`
+
+func TestImportGraphQL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.graphql")
+	writeFile(t, path, petstoreSDL)
+
+	docs, err := ImportGraphQL(path)
+	if err != nil {
+		t.Fatalf("ImportGraphQL failed: %v", err)
+	}
+	if docs.Schema != path {
+		t.Errorf("expected schema path %q, got %q", path, docs.Schema)
+	}
+
+	if len(docs.Queries) != 3 || len(docs.Mutations) != 1 || len(docs.Subscriptions) != 0 {
+		t.Fatalf("unexpected operations: %+v", docs)
+	}
+	pet := docs.Queries[0]
+	if pet.Name != "pet" || pet.Type != "Pet" || pet.Description != "Look up a pet by its ID" {
+		t.Errorf("unexpected pet query: %+v", pet)
+	}
+	if len(pet.Arguments) != 1 || pet.Arguments[0].Name != "id" || pet.Arguments[0].Type != "ID!" {
+		t.Errorf("unexpected pet arguments: %+v", pet.Arguments)
+	}
+
+	pets := docs.Queries[1]
+	if pets.Type != "[Pet!]!" || pets.Description != "List pets, newest first" || len(pets.Arguments) != 4 {
+		t.Fatalf("unexpected pets query: %+v", pets)
+	}
+	if kind := pets.Arguments[0]; kind.Description != "Only pets of this kind" || kind.Type != "Kind" {
+		t.Errorf("unexpected kind argument: %+v", kind)
+	}
+	if first := pets.Arguments[1]; first.Default != "10" {
+		t.Errorf("expected default 10, got %+v", first)
+	}
+	if filter := pets.Arguments[3]; filter.Default != `{tags: ["cute"], minAge: 1}` {
+		t.Errorf("expected the object default as written, got %q", filter.Default)
+	}
+	if docs.Queries[2].Deprecated != "Use pets with a filter" {
+		t.Errorf("expected a deprecation reason, got %+v", docs.Queries[2])
+	}
+	if docs.Mutations[0].Name != "addPet" || docs.Mutations[0].Type != "Pet!" {
+		t.Errorf("unexpected mutation: %+v", docs.Mutations[0])
+	}
+
+	var names []string
+	for _, graphQLType := range docs.Types {
+		names = append(names, graphQLType.Kind+" "+graphQLType.Name)
+	}
+	want := "type Pet, union Owner, type Person, type Shelter, enum Kind, input NewPet, input PetFilter, scalar Time"
+	if strings.Join(names, ", ") != want {
+		t.Errorf("expected reachable types %s, got %s", want, strings.Join(names, ", "))
+	}
+	if petType := docs.Types[0]; petType.Description != "A pet in the store" || len(petType.Fields) != 5 {
+		t.Errorf("unexpected Pet type: %+v", petType)
+	}
+	if kind := docs.Types[4]; strings.Join(kind.Values, ",") != "DOG,CAT" {
+		t.Errorf("unexpected enum values: %v", kind.Values)
+	}
+
+	docs.Endpoint = "https://api.example.com/graphql"
+	if err := validation.ValidateStruct(docs); err != nil {
+		t.Errorf("expected imported GraphQL docs to validate: %v", err)
+	}
+}
+
+func TestImportGraphQL_Extend(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "schema.graphqls")
+	second := filepath.Join(dir, "pets.graphqls")
+	writeFile(t, first, "type Query {\n  health: Boolean!\n}\n")
+	writeFile(t, second, "extend type Query {\n  pets: [String!]!\n}\ntype Subscription { petAdded: String }\n")
+
+	docs, err := ImportGraphQL(first, second)
+	if err != nil {
+		t.Fatalf("ImportGraphQL failed: %v", err)
+	}
+	if len(docs.Queries) != 2 || docs.Queries[1].Name != "pets" || len(docs.Subscriptions) != 1 {
+		t.Errorf("expected the extension merged into Query, got %+v", docs)
+	}
+	if docs.Schema != dir {
+		t.Errorf("expected the schema directory for several files, got %q", docs.Schema)
+	}
+}
+
+func TestImportGraphQL_Errors(t *testing.T) {
+	tests := map[string]string{
+		"operation":    "query { pets }",
+		"no root":      "type Pet { id: ID }",
+		"unterminated": "type Query { pet(id: ID!: Pet }",
+		"bad string":   `"unterminated`,
+	}
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "schema.graphql")
+			writeFile(t, path, src)
+			if _, err := ImportGraphQL(path); err == nil {
+				t.Errorf("expected an error for %q", src)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// HelpTimeout bounds each --help invocation
+var HelpTimeout = 10 * time.Second
+
+// maxHelpDepth stops recursion into deeply nested or self-referencing
+// subcommands
+const maxHelpDepth = 3
+
+var (
+	columnGap          = regexp.MustCompile(`\s{2,}`)
+	helpDefaultPattern = regexp.MustCompile(`\s*[(\[]default:?\s*"?([^")\]]*)"?[)\]]`)
+	helpEnvPattern     = regexp.MustCompile(`\s*\[(?:\$[A-Z0-9_, $]+|env:\s*[^\]]*)\]`)
+	helpRequiredFlag   = regexp.MustCompile(`(?i)\s*[(\[]required[)\]]`)
+	argparseChoices    = regexp.MustCompile(`^\{[\w,-]+\}$`)
+)
+
+// skippedCommands are generated by CLI frameworks rather than the tool
+var skippedCommands = map[string]bool{"help": true, "completion": true}
+
+// Help is what was learned from a CLI's help output
+type Help struct {
+	BasicUsage string
+	Commands   []models.Command
+}
+
+// helpPage is one parsed --help output
+type helpPage struct {
+	usage       string
+	description string
+	commands    []models.Command // name and description only
+	flags       []models.Flag
+	globalFlags []models.Flag
+}
+
+// ImportHelp runs a locally built CLI with --help, follows the subcommands
+// it lists and turns the output into commands and flags. args are passed
+// before the subcommand, e.g. for `go run . --help`. Cobra, urfave/cli,
+// argparse and clap layouts are recognised.
+func ImportHelp(binary string, args ...string) (*Help, error) {
+	root, err := runHelp(binary, args)
+	if err != nil {
+		return nil, err
+	}
+
+	help := &Help{BasicUsage: root.usage}
+	if len(root.flags)+len(root.globalFlags) > 0 || len(root.commands) == 0 {
+		// the program name in the usage line is what users type, which
+		// for a script such as ap.py may differ from the file run
+		name := filepath.Base(binary)
+		if fields := strings.Fields(root.usage); len(fields) > 0 && !strings.ContainsAny(fields[0], "[<{-") {
+			name = fields[0]
+		}
+		description := root.description
+		if description == "" {
+			description = name
+		}
+		help.Commands = append(help.Commands, models.Command{
+			Name:        name,
+			Description: description,
+			Flags:       append(root.flags, root.globalFlags...),
+		})
+	}
+
+	var visit func(page *helpPage, path []string)
+	visit = func(page *helpPage, path []string) {
+		for _, listed := range page.commands {
+			command := listed
+			commandPath := append(append([]string(nil), path...), strings.Fields(listed.Name)...)
+			command.Name = strings.Join(commandPath, " ")
+
+			// a subcommand whose help can't be read is kept without flags
+			sub, err := runHelp(binary, append(append([]string(nil), args...), commandPath...))
+			if err == nil {
+				command.Flags = sub.flags
+				if command.Description == "" {
+					command.Description = sub.description
+				}
+			}
+			help.Commands = append(help.Commands, command)
+
+			if err == nil && len(commandPath) < maxHelpDepth {
+				visit(sub, commandPath)
+			}
+		}
+	}
+	visit(root, nil)
+
+	return help, nil
+}
+
+// runHelp runs binary with --help and parses its output. Some tools exit
+// non-zero after printing help, so output is preferred over the exit code.
+func runHelp(binary string, args []string) (*helpPage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), HelpTimeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, binary, append(args, "--help")...)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || output.Len() == 0) {
+		return nil, fmt.Errorf("failed to run %s --help: %w", strings.Join(append([]string{binary}, args...), " "), err)
+	}
+	return parseHelp(output.String()), nil
+}
+
+// parseHelp splits help output into sections by their headers, such as
+// "Flags:", "GLOBAL OPTIONS:" or "positional arguments:", and reads the
+// entries of each
+func parseHelp(output string) *helpPage {
+	page := &helpPage{}
+	lines := strings.Split(strings.ReplaceAll(output, "\t", "    "), "\n")
+
+	var (
+		section     string
+		entryIndent int
+		usageDone   bool
+		inChoices   bool // argparse lists subcommands below a {a,b} line
+		choiceDepth int
+		description []string
+		appendDesc  func(string)
+	)
+	for _, raw := range lines {
+		line := strings.TrimRight(raw, " ")
+		trimmed := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		if trimmed == "" {
+			appendDesc = nil
+			if section == "usage" && page.usage != "" {
+				usageDone = true
+			}
+			continue
+		}
+
+		// section headers start at the margin, e.g. "Flags:" or "usage: tool [-h]"
+		if indent == 0 {
+			if header, rest, ok := sectionHeader(trimmed); ok {
+				section, appendDesc, inChoices = header, nil, false
+				if section == "usage" && rest != "" {
+					page.usage = rest
+				}
+				continue
+			}
+		}
+
+		switch section {
+		case "", "description":
+			// text before the first section describes the tool
+			description = append(description, trimmed)
+		case "name":
+			// urfave/cli prints "NAME:\n   tool - what it does"
+			if _, text, found := strings.Cut(trimmed, " - "); found {
+				description = append(description, text)
+			}
+		case "usage":
+			if usageDone {
+				// argparse describes the tool below the usage; headers
+				// this doesn't know, such as "Aliases:", end it
+				if indent == 0 && !strings.HasSuffix(trimmed, ":") {
+					description = append(description, trimmed)
+				} else {
+					section = "other"
+				}
+				continue
+			}
+			// the first usage line, joined with its wrapped continuation
+			if page.usage == "" || indent > 2 {
+				page.usage = strings.TrimSpace(page.usage + " " + trimmed)
+			} else {
+				usageDone = true
+			}
+		case "commands", "positional":
+			if section == "positional" {
+				if argparseChoices.MatchString(trimmed) {
+					inChoices, choiceDepth = true, indent
+					continue
+				}
+				if !inChoices || indent <= choiceDepth {
+					inChoices = false
+					continue
+				}
+			}
+			if appendDesc != nil && indent > entryIndent {
+				appendDesc(trimmed)
+				continue
+			}
+			command, ok := parseCommandEntry(trimmed)
+			if !ok {
+				continue
+			}
+			entryIndent = indent
+			page.commands = append(page.commands, command)
+			last := &page.commands[len(page.commands)-1]
+			appendDesc = func(text string) { last.Description = strings.TrimSpace(last.Description + " " + text) }
+		case "flags", "global":
+			if !strings.HasPrefix(trimmed, "-") {
+				if appendDesc != nil && indent > entryIndent {
+					appendDesc(trimmed)
+				}
+				continue
+			}
+			flag, ok := parseFlagEntry(trimmed)
+			if !ok {
+				appendDesc = nil
+				continue
+			}
+			entryIndent = indent
+			flags := &page.flags
+			if section == "global" {
+				flags = &page.globalFlags
+			}
+			*flags = append(*flags, flag)
+			last := &(*flags)[len(*flags)-1]
+			appendDesc = func(text string) { cleanFlagDescription(last, last.Description+" "+text) }
+		}
+	}
+
+	page.description = strings.Join(description, " ")
+	return page
+}
+
+// sectionHeader classifies lines such as "Available Commands:", "OPTIONS:"
+// or "Usage: tool [OPTIONS]"
+func sectionHeader(line string) (string, string, bool) {
+	name, rest, found := strings.Cut(line, ":")
+	if !found || len(name) > 40 {
+		return "", "", false
+	}
+	lower := strings.ToLower(name)
+	rest = strings.TrimSpace(rest)
+
+	switch {
+	case strings.Contains(lower, "usage"):
+		return "usage", rest, true
+	case rest != "":
+		return "", "", false
+	case lower == "name" || lower == "description":
+		return lower, "", true
+	case strings.Contains(lower, "global"):
+		return "global", "", true
+	case strings.Contains(lower, "positional"):
+		return "positional", "", true
+	case strings.Contains(lower, "command"):
+		return "commands", "", true
+	case strings.Contains(lower, "flag") || strings.Contains(lower, "option"):
+		return "flags", "", true
+	case strings.Contains(lower, "argument") || strings.Contains(lower, "example"):
+		return "other", "", true
+	}
+	return "", "", false
+}
+
+// parseCommandEntry reads "serve    Serve the directory" or urfave's
+// "serve, s  Serve the directory"
+func parseCommandEntry(line string) (models.Command, bool) {
+	parts := columnGap.Split(line, 2)
+	name, _, _ := strings.Cut(parts[0], ",")
+	name = strings.TrimSpace(name)
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " <[") || skippedCommands[name] {
+		return models.Command{}, false
+	}
+
+	command := models.Command{Name: name}
+	if len(parts) == 2 {
+		command.Description = strings.TrimSpace(parts[1])
+	}
+	return command, true
+}
+
+// parseFlagEntry reads flag lines such as
+//
+//	-o, --output string   Output file (default "README.md")
+//	--port value, -p value  Port (default: 8080) [$PORT]
+//	-p PORT, --port PORT  Port
+//	-p, --port <PORT>  Port [default: 8080]
+func parseFlagEntry(line string) (models.Flag, bool) {
+	parts := columnGap.Split(line, 2)
+
+	var flag models.Flag
+	for _, spec := range strings.Split(parts[0], ",") {
+		spec = strings.TrimSpace(spec)
+		name, _, _ := strings.Cut(spec, " ")
+		name, _, _ = strings.Cut(name, "=")
+		switch {
+		case strings.HasPrefix(name, "--"):
+			flag.Name = strings.TrimPrefix(name, "--")
+		case strings.HasPrefix(name, "-") && len(name) == 2:
+			flag.Short = name[1:]
+		}
+	}
+	if flag.Name == "" {
+		flag.Name, flag.Short = flag.Short, ""
+	}
+	if flag.Name == "" || flag.Name == "help" || flag.Name == "h" {
+		return models.Flag{}, false
+	}
+
+	if len(parts) == 2 {
+		cleanFlagDescription(&flag, parts[1])
+	}
+	return flag, true
+}
+
+// cleanFlagDescription moves defaults, env hints and required markers out
+// of a flag's description
+func cleanFlagDescription(flag *models.Flag, description string) {
+	if match := helpDefaultPattern.FindStringSubmatch(description); match != nil {
+		flag.Default = match[1]
+		description = helpDefaultPattern.ReplaceAllString(description, "")
+	}
+	if helpRequiredFlag.MatchString(description) {
+		flag.Required = true
+		description = helpRequiredFlag.ReplaceAllString(description, "")
+	}
+	description = helpEnvPattern.ReplaceAllString(description, "")
+	flag.Description = strings.TrimSpace(description)
+}
@@ -0,0 +1,211 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestParseHelp_Cobra(t *testing.T) {
+	page := parseHelp(`Serve files over HTTP
+
+Usage:
+  srv [flags]
+  srv [command]
+
+Available Commands:
+  completion  Generate the autocompletion script for the specified shell
+  help        Help about any command
+  serve       Serve a directory
+
+Flags:
+  -h, --help            help for srv
+  -p, --port int        Port to listen on (default 8080)
+      --root string     Directory to serve (default ".")
+      --token string    API token (required)
+
+Use "srv [command] --help" for more information about a command.
+`)
+
+	if page.usage != "srv [flags]" || page.description != "Serve files over HTTP" {
+		t.Errorf("unexpected usage %q and description %q", page.usage, page.description)
+	}
+	if len(page.commands) != 1 || page.commands[0].Name != "serve" || page.commands[0].Description != "Serve a directory" {
+		t.Errorf("unexpected commands: %+v", page.commands)
+	}
+	wantFlags := []models.Flag{
+		{Name: "port", Short: "p", Description: "Port to listen on", Default: "8080"},
+		{Name: "root", Description: "Directory to serve", Default: "."},
+		{Name: "token", Description: "API token", Required: true},
+	}
+	assertFlags(t, page.flags, wantFlags)
+}
+
+func TestParseHelp_Urfave(t *testing.T) {
+	page := parseHelp(`NAME:
+   srv - serve files over HTTP
+
+USAGE:
+   srv [global options] command [command options] [arguments...]
+
+COMMANDS:
+   serve, s  Serve a directory
+   help, h   Shows a list of commands or help for one command
+
+GLOBAL OPTIONS:
+   --port value, -p value  Port to listen on (default: 8080) [$SRV_PORT]
+   --help, -h              show help
+`)
+
+	if page.usage != "srv [global options] command [command options] [arguments...]" || page.description != "serve files over HTTP" {
+		t.Errorf("unexpected usage %q and description %q", page.usage, page.description)
+	}
+	if len(page.commands) != 1 || page.commands[0].Name != "serve" {
+		t.Errorf("unexpected commands: %+v", page.commands)
+	}
+	assertFlags(t, page.globalFlags, []models.Flag{
+		{Name: "port", Short: "p", Description: "Port to listen on", Default: "8080"},
+	})
+}
+
+func TestParseHelp_Argparse(t *testing.T) {
+	page := parseHelp(`usage: srv [-h] [--port PORT] [--verbose]
+           {serve,init} ... path
+
+Serve files over HTTP
+
+positional arguments:
+  {serve,init}
+    serve        Serve a directory
+    init         Create a config file with a long
+                 wrapped description
+  path           Not a command
+
+options:
+  -h, --help     show this help message and exit
+  -p PORT, --port PORT
+                 Port to listen on
+  --verbose
+`)
+
+	if page.usage != "srv [-h] [--port PORT] [--verbose] {serve,init} ... path" {
+		t.Errorf("unexpected usage %q", page.usage)
+	}
+	if page.description != "Serve files over HTTP" {
+		t.Errorf("unexpected description %q", page.description)
+	}
+	want := []models.Command{
+		{Name: "serve", Description: "Serve a directory"},
+		{Name: "init", Description: "Create a config file with a long wrapped description"},
+	}
+	if len(page.commands) != len(want) {
+		t.Fatalf("expected commands %+v, got %+v", want, page.commands)
+	}
+	for i := range want {
+		if page.commands[i].Name != want[i].Name || page.commands[i].Description != want[i].Description {
+			t.Errorf("command %d: expected %+v, got %+v", i, want[i], page.commands[i])
+		}
+	}
+	assertFlags(t, page.flags, []models.Flag{
+		{Name: "port", Short: "p", Description: "Port to listen on"},
+		{Name: "verbose"},
+	})
+}
+
+func TestParseHelp_Clap(t *testing.T) {
+	page := parseHelp(`Serve files over HTTP
+
+Usage: srv [OPTIONS] <COMMAND>
+
+Commands:
+  serve  Serve a directory
+  help   Print this message or the help of the given subcommand(s)
+
+Options:
+  -p, --port <PORT>  Port to listen on [default: 8080]
+  -c, --config <FILE>  Config file [env: SRV_CONFIG=]
+  -h, --help         Print help
+`)
+
+	if page.usage != "srv [OPTIONS] <COMMAND>" {
+		t.Errorf("unexpected usage %q", page.usage)
+	}
+	if len(page.commands) != 1 || page.commands[0].Name != "serve" {
+		t.Errorf("unexpected commands: %+v", page.commands)
+	}
+	assertFlags(t, page.flags, []models.Flag{
+		{Name: "port", Short: "p", Description: "Port to listen on", Default: "8080"},
+		{Name: "config", Short: "c", Description: "Config file"},
+	})
+}
+
+func TestImportHelp(t *testing.T) {
+	// a fake CLI that answers --help for itself and one subcommand
+	binary := filepath.Join(t.TempDir(), "srv")
+	script := `#!/bin/sh
+if [ "$1" = "serve" ]; then
+  printf 'Serve a directory\n\nUsage:\n  srv serve [flags]\n\nFlags:\n  -p, --port int   Port to listen on (default 8080)\n'
+  exit 0
+fi
+printf 'Usage:\n  srv [command]\n\nAvailable Commands:\n  serve   Serve a directory\n'
+exit 2
+`
+	if err := os.WriteFile(binary, []byte(script), 0o755); err != nil {
+		t.Fatalf("failed to write fake binary: %v", err)
+	}
+
+	help, err := ImportHelp(binary)
+	if err != nil {
+		t.Fatalf("ImportHelp failed: %v", err)
+	}
+
+	if help.BasicUsage != "srv [command]" {
+		t.Errorf("unexpected usage %q", help.BasicUsage)
+	}
+	if len(help.Commands) != 1 || help.Commands[0].Name != "serve" {
+		t.Fatalf("unexpected commands: %+v", help.Commands)
+	}
+	assertFlags(t, help.Commands[0].Flags, []models.Flag{
+		{Name: "port", Short: "p", Description: "Port to listen on", Default: "8080"},
+	})
+}
+
+func TestImportHelp_Argparse(t *testing.T) {
+	// a script is named after its prog rather than its file
+	binary := filepath.Join(t.TempDir(), "ap.py")
+	script := `#!/bin/sh
+printf 'usage: srv [-h] [--port PORT]\n\nServe files over HTTP\n\noptions:\n  -h, --help   show this help message and exit\n  --port PORT  Port to listen on\n'
+`
+	if err := os.WriteFile(binary, []byte(script), 0o755); err != nil {
+		t.Fatalf("failed to write fake binary: %v", err)
+	}
+
+	help, err := ImportHelp(binary)
+	if err != nil {
+		t.Fatalf("ImportHelp failed: %v", err)
+	}
+	if len(help.Commands) != 1 || help.Commands[0].Name != "srv" || help.Commands[0].Description != "Serve files over HTTP" {
+		t.Fatalf("unexpected commands: %+v", help.Commands)
+	}
+}
+
+func TestImportHelp_MissingBinary(t *testing.T) {
+	if _, err := ImportHelp(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing binary")
+	}
+}
+
+func assertFlags(t *testing.T, got, want []models.Flag) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("expected flags %+v, got %+v", want, got)
+		return
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("flag %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
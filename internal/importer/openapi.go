@@ -0,0 +1,642 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// openAPIMethods are the operations the Endpoint model can describe
+var openAPIMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true,
+	"options": true, "head": true, "patch": true,
+}
+
+// maxEndpointDescription matches the limit on Endpoint.Description
+const maxEndpointDescription = 200
+
+// OpenAPI is what was imported from an OpenAPI or Swagger document
+type OpenAPI struct {
+	Title       string
+	Description string
+	APIDocs     models.APIDocs
+	Auth        *models.Auth
+}
+
+// openAPIDoc walks a parsed document, following local $ref pointers
+type openAPIDoc struct {
+	root    *yaml.Node
+	swagger bool // Swagger 2.0 rather than OpenAPI 3
+}
+
+// ImportOpenAPI reads an OpenAPI 3 or Swagger 2 document in YAML or JSON.
+// It fills the base URL, the endpoints with their parameters and example
+// responses, the status codes and errors from the responses, and the
+// authentication from the security schemes.
+func ImportOpenAPI(path string) (*OpenAPI, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(root.Content) == 0 {
+		return nil, fmt.Errorf("%s: empty document", path)
+	}
+
+	doc := &openAPIDoc{root: root.Content[0]}
+	switch {
+	case strings.HasPrefix(doc.text(doc.root, "openapi"), "3"):
+	case doc.text(doc.root, "swagger") == "2.0":
+		doc.swagger = true
+	default:
+		return nil, fmt.Errorf("%s: not an OpenAPI 3 or Swagger 2.0 document", path)
+	}
+
+	info := doc.get(doc.root, "info")
+	imported := &OpenAPI{
+		Title:       doc.text(info, "title"),
+		Description: flatten(doc.text(info, "description")),
+	}
+	imported.APIDocs.BaseURL = doc.baseURL()
+	imported.Auth, imported.APIDocs.Authentication = doc.auth()
+	imported.APIDocs.Endpoints, imported.APIDocs.ErrorHandling = doc.endpoints()
+
+	return imported, nil
+}
+
+// baseURL uses the first server, or host and basePath for Swagger 2.0
+func (d *openAPIDoc) baseURL() string {
+	if d.swagger {
+		host := d.text(d.root, "host")
+		if host == "" {
+			return d.text(d.root, "basePath")
+		}
+		scheme := "https"
+		if schemes := d.get(d.root, "schemes"); schemes != nil && len(schemes.Content) > 0 {
+			scheme = schemes.Content[0].Value
+		}
+		return scheme + "://" + host + d.text(d.root, "basePath")
+	}
+
+	servers := d.get(d.root, "servers")
+	if servers == nil || len(servers.Content) == 0 {
+		return ""
+	}
+	server := d.resolve(servers.Content[0])
+	baseURL := d.text(server, "url")
+	// substitute server variables with their defaults
+	d.each(d.get(server, "variables"), func(name string, variable *yaml.Node) {
+		baseURL = strings.ReplaceAll(baseURL, "{"+name+"}", d.text(variable, "default"))
+	})
+	return strings.TrimSuffix(baseURL, "/")
+}
+
+// auth describes the first security scheme, preferring one the document
+// requires globally
+func (d *openAPIDoc) auth() (*models.Auth, string) {
+	schemes := d.get(d.root, "components", "securitySchemes")
+	if d.swagger {
+		schemes = d.get(d.root, "securityDefinitions")
+	}
+	if schemes == nil || len(schemes.Content) == 0 {
+		return nil, "None"
+	}
+
+	name := schemes.Content[0].Value
+	if security := d.get(d.root, "security"); security != nil {
+		for _, requirement := range security.Content {
+			if requirement.Kind == yaml.MappingNode && len(requirement.Content) > 0 {
+				name = requirement.Content[0].Value
+				break
+			}
+		}
+	}
+	scheme := d.get(schemes, name)
+	if scheme == nil {
+		return nil, "None"
+	}
+
+	auth := &models.Auth{}
+	var description string
+	switch strings.ToLower(d.text(scheme, "type")) {
+	case "http":
+		if strings.EqualFold(d.text(scheme, "scheme"), "basic") {
+			auth.Method = "basic"
+			auth.ExampleUsage = "Authorization: Basic <base64 username:password>"
+			description = "HTTP basic authentication"
+			break
+		}
+		auth.Method = "jwt"
+		auth.TokenFormat = d.text(scheme, "bearerFormat")
+		if auth.TokenFormat == "" {
+			auth.TokenFormat = "Bearer token"
+		}
+		auth.ExampleUsage = "Authorization: Bearer <token>"
+		description = "Bearer token in the Authorization header"
+	case "basic":
+		auth.Method = "basic"
+		auth.ExampleUsage = "Authorization: Basic <base64 username:password>"
+		description = "HTTP basic authentication"
+	case "apikey":
+		keyName, in := d.text(scheme, "name"), d.text(scheme, "in")
+		auth.Method = "api-key"
+		auth.KeyName, auth.KeyIn = keyName, in
+		auth.TokenFormat = fmt.Sprintf("API key in the %s %s", keyName, in)
+		if in == "header" {
+			auth.ExampleUsage = keyName + ": <api-key>"
+		} else {
+			auth.ExampleUsage = fmt.Sprintf("?%s=<api-key>", keyName)
+		}
+		description = auth.TokenFormat
+	case "oauth2", "openidconnect":
+		auth.Method = "oauth"
+		auth.ExampleUsage = "Authorization: Bearer <access-token>"
+		description = "OAuth 2.0 access token in the Authorization header"
+		auth.Endpoints = d.oauthEndpoints(scheme)
+	default:
+		return nil, "None"
+	}
+	if text := flatten(d.text(scheme, "description")); text != "" {
+		description = text
+	}
+	return auth, description
+}
+
+// oauthEndpoints lists the paths of the token and authorization urls
+func (d *openAPIDoc) oauthEndpoints(scheme *yaml.Node) []string {
+	var urls []string
+	for _, key := range []string{"authorizationUrl", "tokenUrl", "refreshUrl", "openIdConnectUrl"} {
+		urls = append(urls, d.text(scheme, key))
+	}
+	d.each(d.get(scheme, "flows"), func(_ string, flow *yaml.Node) {
+		for _, key := range []string{"authorizationUrl", "tokenUrl", "refreshUrl"} {
+			urls = append(urls, d.text(flow, key))
+		}
+	})
+
+	var endpoints []string
+	for _, raw := range urls {
+		parsed, err := url.Parse(raw)
+		if raw == "" || err != nil || !strings.HasPrefix(parsed.Path, "/") {
+			continue
+		}
+		if !slices.Contains(endpoints, parsed.Path) {
+			endpoints = append(endpoints, parsed.Path)
+		}
+	}
+	return endpoints
+}
+
+// endpoints reads every operation in document order and collects the
+// status codes and errors their responses declare
+func (d *openAPIDoc) endpoints() ([]models.Endpoint, *models.ErrorHandling) {
+	var (
+		endpoints     []models.Endpoint
+		statusCodes   = map[int]models.StatusCode{}
+		commonErrors  = map[int]models.CommonError{}
+		errorResponse models.ErrorResponse
+	)
+
+	d.each(d.get(d.root, "paths"), func(path string, item *yaml.Node) {
+		pathParameters := d.get(item, "parameters")
+		d.each(item, func(method string, operation *yaml.Node) {
+			if !openAPIMethods[method] {
+				return
+			}
+			endpoint := models.Endpoint{
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				Description: truncate(firstNonEmpty(d.text(operation, "summary"), flatten(d.text(operation, "description"))), maxEndpointDescription),
+			}
+			endpoint.Parameters = d.parameters(pathParameters, d.get(operation, "parameters"))
+			endpoint.RequestBody = d.requestExample(operation)
+
+			d.each(d.get(operation, "responses"), func(code string, response *yaml.Node) {
+				status, err := strconv.Atoi(code)
+				if err != nil {
+					return // "default" and ranges like "5XX"
+				}
+				description := flatten(d.text(response, "description"))
+				example := d.responseExample(response)
+
+				if status >= 200 && status < 300 && endpoint.Response == "" {
+					endpoint.Response = firstNonEmpty(example, fmt.Sprintf("%d %s", status, description))
+				}
+				if _, seen := statusCodes[status]; !seen {
+					statusCodes[status] = models.StatusCode{Code: status, Description: description}
+				}
+				if status >= 400 {
+					if _, seen := commonErrors[status]; !seen {
+						commonErrors[status] = models.CommonError{
+							Code:        status,
+							Message:     description,
+							Description: fmt.Sprintf("Returned by %s %s", endpoint.Method, path),
+						}
+					}
+					if errorResponse.Structure == "" {
+						if structure := d.schemaSkeleton(d.responseSchema(response), 0); structure != nil {
+							errorResponse.Structure = toJSON(structure)
+							errorResponse.Example = firstNonEmpty(example, errorResponse.Structure)
+						}
+					}
+				}
+			})
+
+			endpoints = append(endpoints, endpoint)
+		})
+	})
+
+	if len(statusCodes) == 0 {
+		return endpoints, nil
+	}
+	errorHandling := &models.ErrorHandling{Format: "json", ErrorResponse: errorResponse}
+	for _, code := range slices.Sorted(maps.Keys(statusCodes)) {
+		errorHandling.StatusCodes = append(errorHandling.StatusCodes, statusCodes[code])
+	}
+	for _, code := range slices.Sorted(maps.Keys(commonErrors)) {
+		errorHandling.CommonErrors = append(errorHandling.CommonErrors, commonErrors[code])
+	}
+	return endpoints, errorHandling
+}
+
+// parameters merges path level and operation parameters, the operation
+// winning when both declare the same name. Authorization headers are left
+// to the auth scheme and cookies aren't modelled.
+func (d *openAPIDoc) parameters(lists ...*yaml.Node) []models.Parameter {
+	var parameters []models.Parameter
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		for _, node := range list.Content {
+			node = d.resolve(node)
+			in := d.text(node, "in")
+			switch {
+			case in == "body":
+				continue // Swagger 2.0 request bodies are read by requestExample
+			case in == "formData":
+				in = "body"
+			case in == "cookie", in == "header" && strings.EqualFold(d.text(node, "name"), "Authorization"):
+				continue
+			}
+
+			schema := d.get(node, "schema")
+			if schema == nil {
+				schema = node // Swagger 2.0 keeps the type on the parameter
+			}
+			parameter := models.Parameter{
+				Name:        d.text(node, "name"),
+				In:          in,
+				Type:        parameterType(d.text(schema, "type"), d.text(schema, "format")),
+				Required:    d.text(node, "required") == "true",
+				Description: truncate(flatten(d.text(node, "description")), maxEndpointDescription),
+				Example: firstNonEmpty(
+					d.scalar(d.get(node, "example")),
+					d.firstExample(d.get(node, "examples")),
+					d.scalar(d.get(schema, "example")),
+					d.scalar(d.get(schema, "default")),
+					d.scalar(d.first(d.get(schema, "enum"))),
+				),
+			}
+			d.constrain(&parameter, schema)
+
+			replaced := false
+			for i := range parameters {
+				if parameters[i].Name == parameter.Name {
+					parameters[i], replaced = parameter, true
+				}
+			}
+			if !replaced {
+				parameters = append(parameters, parameter)
+			}
+		}
+	}
+	return parameters
+}
+
+// constrain copies a schema's format, enum, bounds and pattern onto a
+// parameter. Array enums come from their items.
+func (d *openAPIDoc) constrain(parameter *models.Parameter, schema *yaml.Node) {
+	if format := d.text(schema, "format"); format != "date-time" && format != "binary" {
+		parameter.Format = format
+	}
+	parameter.Pattern = d.text(schema, "pattern")
+
+	enum := d.get(schema, "enum")
+	if enum == nil {
+		enum = d.get(schema, "items", "enum")
+	}
+	if enum != nil {
+		for _, value := range enum.Content {
+			if value := d.scalar(value); value != "" {
+				parameter.Enum = append(parameter.Enum, value)
+			}
+		}
+	}
+
+	minKey, maxKey := "minLength", "maxLength"
+	switch parameter.Type {
+	case "int", "number":
+		minKey, maxKey = "minimum", "maximum"
+	case "array":
+		minKey, maxKey = "minItems", "maxItems"
+	}
+	parameter.Min, parameter.Max = d.number(schema, minKey), d.number(schema, maxKey)
+}
+
+// requestExample renders the example JSON body of an operation, built from
+// its schema when no example is given
+func (d *openAPIDoc) requestExample(operation *yaml.Node) string {
+	var media *yaml.Node
+	if parameters := d.get(operation, "parameters"); d.swagger && parameters != nil {
+		for _, node := range parameters.Content {
+			if node = d.resolve(node); d.text(node, "in") == "body" {
+				media = node
+			}
+		}
+	} else if !d.swagger {
+		media = d.jsonMedia(d.get(operation, "requestBody"))
+	}
+	if media == nil {
+		return ""
+	}
+
+	if example := firstNonEmpty(
+		d.render(d.get(media, "example")),
+		d.firstExample(d.get(media, "examples")),
+	); json.Valid([]byte(example)) && strings.ContainsAny(example[:1], "{[") {
+		return example
+	}
+	switch skeleton := d.schemaSkeleton(d.get(media, "schema"), 0); skeleton.(type) {
+	case map[string]interface{}, []interface{}:
+		return toJSON(skeleton)
+	}
+	return ""
+}
+
+// parameterType maps schema types onto the Parameter model's types
+func parameterType(schemaType, format string) string {
+	switch schemaType {
+	case "integer":
+		return "int"
+	case "number", "array", "object", "file":
+		return schemaType
+	case "boolean":
+		return "bool"
+	}
+	switch format {
+	case "date-time":
+		return "date-time"
+	case "binary":
+		return "file"
+	}
+	return "string"
+}
+
+// responseExample renders the example of a response's JSON body, if any
+func (d *openAPIDoc) responseExample(response *yaml.Node) string {
+	if d.swagger {
+		return d.render(d.first(d.get(response, "examples")))
+	}
+	media := d.jsonMedia(response)
+	return firstNonEmpty(
+		d.render(d.get(media, "example")),
+		d.firstExample(d.get(media, "examples")),
+		d.render(d.get(media, "schema", "example")),
+	)
+}
+
+func (d *openAPIDoc) responseSchema(response *yaml.Node) *yaml.Node {
+	if d.swagger {
+		return d.get(response, "schema")
+	}
+	return d.get(d.jsonMedia(response), "schema")
+}
+
+// jsonMedia picks the JSON media type of a response, or the first one
+func (d *openAPIDoc) jsonMedia(response *yaml.Node) *yaml.Node {
+	content := d.get(response, "content")
+	if media := d.get(content, "application/json"); media != nil {
+		return media
+	}
+	return d.first(content)
+}
+
+// firstExample returns the value of the first entry of an examples map
+func (d *openAPIDoc) firstExample(examples *yaml.Node) string {
+	example := d.first(examples)
+	if value := d.get(example, "value"); value != nil {
+		return d.render(value)
+	}
+	return ""
+}
+
+// schemaSkeleton builds an example object from a schema's properties
+func (d *openAPIDoc) schemaSkeleton(schema *yaml.Node, depth int) interface{} {
+	schema = d.resolve(schema)
+	if schema == nil || depth > 3 {
+		return nil
+	}
+	if example := d.get(schema, "example"); example != nil {
+		var value interface{}
+		if err := example.Decode(&value); err == nil {
+			return value
+		}
+	}
+
+	switch d.text(schema, "type") {
+	case "array":
+		if item := d.schemaSkeleton(d.get(schema, "items"), depth+1); item != nil {
+			return []interface{}{item}
+		}
+		return []interface{}{}
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	case "string":
+		return firstNonEmpty(d.text(schema, "format"), "string")
+	}
+
+	properties := d.get(schema, "properties")
+	if properties == nil {
+		return nil
+	}
+	object := map[string]interface{}{}
+	d.each(properties, func(name string, property *yaml.Node) {
+		object[name] = d.schemaSkeleton(property, depth+1)
+	})
+	return object
+}
+
+// render turns an example node into text, as indented JSON for objects
+func (d *openAPIDoc) render(node *yaml.Node) string {
+	node = d.resolve(node)
+	if node == nil {
+		return ""
+	}
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		return ""
+	}
+	return toJSON(value)
+}
+
+// scalar returns the text of a scalar node
+func (d *openAPIDoc) scalar(node *yaml.Node) string {
+	node = d.resolve(node)
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return node.Value
+}
+
+// number reads a numeric key, or nil when it's missing or not a number
+func (d *openAPIDoc) number(node *yaml.Node, key string) *float64 {
+	value, err := strconv.ParseFloat(d.scalar(d.get(node, key)), 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// get follows keys through nested mappings, resolving $ref on the way
+func (d *openAPIDoc) get(node *yaml.Node, keys ...string) *yaml.Node {
+	node = d.resolve(node)
+	for _, key := range keys {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = node.Content[i+1]
+				break
+			}
+		}
+		node = d.resolve(next)
+	}
+	return node
+}
+
+func (d *openAPIDoc) text(node *yaml.Node, key string) string {
+	return d.scalar(d.get(node, key))
+}
+
+// each calls fn for every entry of a mapping in document order
+func (d *openAPIDoc) each(node *yaml.Node, fn func(key string, value *yaml.Node)) {
+	node = d.resolve(node)
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i].Value, d.resolve(node.Content[i+1]))
+	}
+}
+
+// first returns the first value of a mapping or sequence
+func (d *openAPIDoc) first(node *yaml.Node) *yaml.Node {
+	node = d.resolve(node)
+	switch {
+	case node == nil:
+		return nil
+	case node.Kind == yaml.MappingNode && len(node.Content) >= 2:
+		return d.resolve(node.Content[1])
+	case node.Kind == yaml.SequenceNode && len(node.Content) >= 1:
+		return d.resolve(node.Content[0])
+	}
+	return nil
+}
+
+// resolve follows local "#/components/..." references
+func (d *openAPIDoc) resolve(node *yaml.Node) *yaml.Node {
+	for range 10 { // guards against reference cycles
+		if node != nil && node.Kind == yaml.AliasNode {
+			node = node.Alias
+			continue
+		}
+		if node == nil || node.Kind != yaml.MappingNode {
+			return node
+		}
+		var ref string
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "$ref" {
+				ref = node.Content[i+1].Value
+			}
+		}
+		pointer, ok := strings.CutPrefix(ref, "#/")
+		if !ok {
+			return node
+		}
+
+		target := d.root
+		for _, key := range strings.Split(pointer, "/") {
+			key = strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~")
+			target = d.lookup(target, key)
+		}
+		node = target
+	}
+	return node
+}
+
+// lookup finds a key in a mapping without resolving references
+func (d *openAPIDoc) lookup(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func toJSON(value interface{}) string {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// flatten joins wrapped text onto one line
+func flatten(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// truncate cuts text to at most max characters at a word boundary
+func truncate(text string, max int) string {
+	if len(text) <= max {
+		return text
+	}
+	cut := strings.LastIndex(text[:max-3], " ")
+	if cut <= 0 {
+		cut = max - 3
+	}
+	return text[:cut] + "..."
+}
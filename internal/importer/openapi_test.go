@@ -0,0 +1,243 @@
+package importer
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+const petstoreOpenAPI = `openapi: 3.0.3
+info:
+  title: Petstore
+  description: A sample pet store API.
+servers:
+  - url: https://{env}.example.com/v1/
+    variables:
+      env:
+        default: api
+security:
+  - bearerAuth: []
+paths:
+  /pets/{petId}:
+    parameters:
+      - $ref: '#/components/parameters/PetId'
+    get:
+      summary: Get a pet by ID
+      parameters:
+        - name: fields
+          in: query
+          description: Fields to include
+          schema:
+            type: string
+            enum: [name, tag]
+      responses:
+        "200":
+          description: The pet
+          content:
+            application/json:
+              example: {id: 1, name: Rex}
+        "404":
+          $ref: '#/components/responses/NotFound'
+    delete:
+      description: Delete a pet
+      responses:
+        "204":
+          description: Deleted
+        "404":
+          $ref: '#/components/responses/NotFound'
+  /pets:
+    trace:
+      responses: {}
+    post:
+      summary: Create a pet
+      parameters:
+        - name: X-Request-ID
+          in: header
+          description: Request tracing ID
+          schema:
+            type: string
+          example: abc-123
+        - name: Authorization
+          in: header
+          schema:
+            type: string
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                age:
+                  type: integer
+      responses:
+        "201":
+          description: Created
+components:
+  parameters:
+    PetId:
+      name: petId
+      in: path
+      required: true
+      description: The pet identifier
+      schema:
+        type: integer
+        minimum: 1
+        example: 42
+  responses:
+    NotFound:
+      description: Pet not found
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Error'
+  schemas:
+    Error:
+      type: object
+      properties:
+        code:
+          type: integer
+        message:
+          type: string
+  securitySchemes:
+    apiKey:
+      type: apiKey
+      name: X-API-Key
+      in: header
+    bearerAuth:
+      type: http
+      scheme: bearer
+      bearerFormat: JWT
+`
+
+func TestImportOpenAPI(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+	writeFile(t, path, petstoreOpenAPI)
+
+	imported, err := ImportOpenAPI(path)
+	if err != nil {
+		t.Fatalf("ImportOpenAPI failed: %v", err)
+	}
+
+	if imported.Title != "Petstore" || imported.APIDocs.BaseURL != "https://api.example.com/v1" {
+		t.Errorf("unexpected title %q and base url %q", imported.Title, imported.APIDocs.BaseURL)
+	}
+	if imported.Auth == nil || imported.Auth.Method != "jwt" || imported.Auth.TokenFormat != "JWT" {
+		t.Errorf("expected the globally required bearer scheme, got %+v", imported.Auth)
+	}
+
+	endpoints := imported.APIDocs.Endpoints
+	if len(endpoints) != 3 {
+		t.Fatalf("expected 3 endpoints (trace skipped), got %+v", endpoints)
+	}
+	get := endpoints[0]
+	if get.Method != "GET" || get.Path != "/pets/{petId}" || get.Description != "Get a pet by ID" {
+		t.Errorf("unexpected endpoint: %+v", get)
+	}
+	minPetID := 1.0
+	wantParameters := []models.Parameter{
+		{Name: "petId", In: "path", Type: "int", Min: &minPetID, Required: true, Description: "The pet identifier", Example: "42"},
+		{Name: "fields", In: "query", Type: "string", Enum: []string{"name", "tag"}, Description: "Fields to include", Example: "name"},
+	}
+	if !reflect.DeepEqual(get.Parameters, wantParameters) {
+		t.Errorf("expected parameters %+v, got %+v", wantParameters, get.Parameters)
+	}
+	if !strings.Contains(get.Response, `"name": "Rex"`) {
+		t.Errorf("expected the JSON example response, got %q", get.Response)
+	}
+	if endpoints[1].Description != "Delete a pet" || endpoints[1].Response != "204 Deleted" {
+		t.Errorf("unexpected delete endpoint: %+v", endpoints[1])
+	}
+	post := endpoints[2]
+	if len(post.Parameters) != 1 || post.Parameters[0].In != "header" || post.Parameters[0].Example != "abc-123" {
+		t.Errorf("expected the request id header parameter (Authorization skipped), got %+v", post.Parameters)
+	}
+	if !strings.Contains(post.RequestBody, `"age": 0`) || !strings.Contains(post.RequestBody, `"name": "string"`) {
+		t.Errorf("expected a request body built from the schema, got %q", post.RequestBody)
+	}
+
+	errorHandling := imported.APIDocs.ErrorHandling
+	if errorHandling == nil {
+		t.Fatal("expected error handling from the responses")
+	}
+	var codes []int
+	for _, statusCode := range errorHandling.StatusCodes {
+		codes = append(codes, statusCode.Code)
+	}
+	if len(codes) != 4 || codes[0] != 200 || codes[3] != 404 {
+		t.Errorf("expected sorted status codes 200, 201, 204, 404, got %v", codes)
+	}
+	if len(errorHandling.CommonErrors) != 1 || errorHandling.CommonErrors[0].Message != "Pet not found" {
+		t.Errorf("unexpected common errors: %+v", errorHandling.CommonErrors)
+	}
+	if !strings.Contains(errorHandling.ErrorResponse.Structure, `"message": "string"`) {
+		t.Errorf("expected an error structure from the schema, got %q", errorHandling.ErrorResponse.Structure)
+	}
+
+	// everything but the error solutions can be validated as is
+	errorHandling.CommonErrors = nil
+	if err := validation.ValidateStruct(&imported.APIDocs); err != nil {
+		t.Errorf("expected imported API docs to validate: %v", err)
+	}
+}
+
+func TestImportOpenAPI_Swagger2(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "swagger.json")
+	writeFile(t, path, `{
+  "swagger": "2.0",
+  "info": {"title": "Legacy"},
+  "host": "legacy.example.com",
+  "basePath": "/api",
+  "schemes": ["http"],
+  "securityDefinitions": {"key": {"type": "apiKey", "name": "token", "in": "query"}},
+  "paths": {
+    "/users": {
+      "get": {
+        "summary": "List users",
+        "parameters": [
+          {"name": "limit", "in": "query", "type": "integer", "default": 20, "description": "Page size"},
+          {"name": "body", "in": "body", "schema": {"type": "object"}}
+        ],
+        "responses": {"200": {"description": "OK", "examples": {"application/json": [{"id": 1}]}}}
+      }
+    }
+  }
+}`)
+
+	imported, err := ImportOpenAPI(path)
+	if err != nil {
+		t.Fatalf("ImportOpenAPI failed: %v", err)
+	}
+
+	if imported.APIDocs.BaseURL != "http://legacy.example.com/api" {
+		t.Errorf("unexpected base url %q", imported.APIDocs.BaseURL)
+	}
+	if imported.Auth == nil || imported.Auth.Method != "api-key" || imported.Auth.ExampleUsage != "?token=<api-key>" ||
+		imported.Auth.KeyName != "token" || imported.Auth.KeyIn != "query" {
+		t.Errorf("unexpected auth: %+v", imported.Auth)
+	}
+	endpoints := imported.APIDocs.Endpoints
+	if len(endpoints) != 1 || len(endpoints[0].Parameters) != 1 {
+		t.Fatalf("expected one endpoint with one parameter, got %+v", endpoints)
+	}
+	if parameter := endpoints[0].Parameters[0]; parameter.In != "query" || parameter.Type != "int" || parameter.Example != "20" {
+		t.Errorf("unexpected parameter: %+v", parameter)
+	}
+	if !strings.Contains(endpoints[0].Response, `"id": 1`) {
+		t.Errorf("expected the example response, got %q", endpoints[0].Response)
+	}
+}
+
+func TestImportOpenAPI_NotOpenAPI(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "other.yaml")
+	writeFile(t, path, "name: not an api\n")
+
+	if _, err := ImportOpenAPI(path); err == nil {
+		t.Error("expected an error for a document without an openapi or swagger version")
+	}
+}
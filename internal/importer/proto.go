@@ -0,0 +1,738 @@
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// protobuf description limits, matching the models
+const (
+	maxProtoDescription      = 500
+	maxProtoFieldDescription = 200
+)
+
+// protoScalars are the built-in field types, which need no resolving
+var protoScalars = map[string]bool{
+	"double": true, "float": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+	"sint32": true, "sint64": true, "fixed32": true, "fixed64": true, "sfixed32": true, "sfixed64": true,
+	"bool": true, "string": true, "bytes": true,
+}
+
+// ImportProto reads protobuf definitions, possibly split over several
+// files. It documents every service with its methods, taking descriptions
+// from the comments above each declaration, and the messages and enums
+// those methods use. Types from files that weren't given, such as
+// google.protobuf.Timestamp, are kept by name only.
+func ImportProto(paths ...string) (*models.GRPCDocs, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no .proto files given")
+	}
+
+	schema := &protoSchema{messages: map[string]*protoMessage{}}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := schema.parse(string(data)); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	docs := schema.docs()
+	if len(docs.Services) == 0 {
+		return nil, fmt.Errorf("%s: no services with methods found", strings.Join(paths, ", "))
+	}
+
+	docs.Proto = paths[0]
+	if len(paths) > 1 {
+		docs.Proto = filepath.Dir(paths[0])
+	}
+	return docs, nil
+}
+
+// protoSchema collects the declarations of one or more .proto files
+type protoSchema struct {
+	messages map[string]*protoMessage // by full name
+	order    []string                 // full names in the order they were declared
+	services []*protoService
+}
+
+// protoMessage is a message or enum declaration
+type protoMessage struct {
+	kind        string // message or enum
+	name        string // full name, e.g. pets.v1.Pet
+	description string
+	fields      []protoField
+	values      []string // enum values
+}
+
+type protoField struct {
+	name        string
+	typ         string // as written, resolved in docs
+	label       string
+	oneof       string
+	description string
+}
+
+type protoService struct {
+	name        string
+	scope       string // package the method types are resolved in
+	description string
+	methods     []models.GRPCMethod
+}
+
+// resolve finds the declaration a type reference names, searching the
+// enclosing scopes from the innermost out as protoc does. References to
+// undeclared types are returned as written.
+func (s *protoSchema) resolve(scope, reference string) string {
+	if protoScalars[reference] {
+		return reference
+	}
+	if strings.HasPrefix(reference, "map<") {
+		key, value, _ := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(reference, "map<"), ">"), ",")
+		return "map<" + strings.TrimSpace(key) + ", " + s.resolve(scope, strings.TrimSpace(value)) + ">"
+	}
+	if strings.HasPrefix(reference, ".") {
+		return reference[1:]
+	}
+
+	for {
+		candidate := reference
+		if scope != "" {
+			candidate = scope + "." + reference
+		}
+		if _, ok := s.messages[candidate]; ok {
+			return candidate
+		}
+		if scope == "" {
+			return reference
+		}
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+// docs turns the schema into the gRPC model, keeping only the messages and
+// enums reachable from the methods
+func (s *protoSchema) docs() *models.GRPCDocs {
+	docs := &models.GRPCDocs{}
+	reachable := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		if strings.HasPrefix(name, "map<") {
+			_, value, _ := strings.Cut(strings.TrimSuffix(name, ">"), ", ")
+			visit(value)
+			return
+		}
+		message, ok := s.messages[name]
+		if !ok || reachable[name] {
+			return
+		}
+		reachable[name] = true
+		for _, field := range message.fields {
+			visit(field.typ)
+		}
+	}
+
+	// resolve the field types first so reachability follows full names
+	for _, name := range s.order {
+		message := s.messages[name]
+		for i := range message.fields {
+			message.fields[i].typ = s.resolve(name, message.fields[i].typ)
+		}
+	}
+
+	for _, service := range s.services {
+		if len(service.methods) == 0 {
+			continue
+		}
+		grpcService := models.GRPCService{
+			Name:        service.name,
+			Description: truncate(service.description, maxProtoDescription),
+		}
+		for _, method := range service.methods {
+			method.Request = s.resolve(service.scope, method.Request)
+			method.Response = s.resolve(service.scope, method.Response)
+			method.Description = truncate(method.Description, maxProtoDescription)
+			visit(method.Request)
+			visit(method.Response)
+			grpcService.Methods = append(grpcService.Methods, method)
+		}
+		docs.Services = append(docs.Services, grpcService)
+	}
+
+	for _, name := range s.order {
+		if !reachable[name] {
+			continue
+		}
+		message := s.messages[name]
+		grpcMessage := models.GRPCMessage{
+			Name:        message.name,
+			Kind:        message.kind,
+			Description: truncate(message.description, maxProtoDescription),
+			Values:      message.values,
+		}
+		for _, field := range message.fields {
+			grpcMessage.Fields = append(grpcMessage.Fields, models.GRPCField{
+				Name:        field.name,
+				Type:        field.typ,
+				Label:       field.label,
+				Oneof:       field.oneof,
+				Description: truncate(field.description, maxProtoFieldDescription),
+			})
+		}
+		docs.Messages = append(docs.Messages, grpcMessage)
+	}
+	return docs
+}
+
+// protoParser is a recursive descent parser over .proto tokens
+type protoParser struct {
+	tokens []protoToken
+	pos    int
+	pkg    string
+	schema *protoSchema
+}
+
+func (s *protoSchema) parse(src string) error {
+	tokens, err := lexProto(src)
+	if err != nil {
+		return err
+	}
+	p := &protoParser{tokens: tokens, schema: s}
+	for p.peek().kind != protoEOF {
+		if err := p.topLevel(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) topLevel() error {
+	if p.skip(";") {
+		return nil
+	}
+	token := p.peek()
+	keyword, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	switch keyword {
+	case "syntax", "edition", "import", "option":
+		return p.skipStatement()
+	case "package":
+		if p.pkg, err = p.fullIdent(); err != nil {
+			return err
+		}
+		return p.expect(";")
+	case "message", "enum":
+		return p.message(keyword, p.pkg, token.comment)
+	case "service":
+		return p.service(token.comment)
+	case "extend":
+		if _, err := p.fullIdent(); err != nil {
+			return err
+		}
+		return p.skipBlock()
+	}
+	return p.errorAt(token, "unexpected "+strconv.Quote(keyword))
+}
+
+// message reads a message or enum declaration and the ones nested in it
+func (p *protoParser) message(kind, scope, description string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	message := &protoMessage{kind: kind, name: qualify(scope, name), description: description}
+	// declare before the body so nested declarations follow their parent
+	p.schema.messages[message.name] = message
+	p.schema.order = append(p.schema.order, message.name)
+
+	if kind == "enum" {
+		return p.enumBody(message)
+	}
+	return p.messageBody(message, "")
+}
+
+func (p *protoParser) messageBody(message *protoMessage, oneof string) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.skip("}") {
+		if p.skip(";") {
+			continue
+		}
+		token := p.peek()
+		if token.kind != protoIdent && !token.is(".") {
+			return p.errorAt(token, "expected a field")
+		}
+
+		switch {
+		case token.value == "message" || token.value == "enum":
+			p.pos++
+			if err := p.message(token.value, message.name, token.comment); err != nil {
+				return err
+			}
+			continue
+		case token.value == "oneof" && oneof == "":
+			p.pos++
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if err := p.messageBody(message, name); err != nil {
+				return err
+			}
+			continue
+		case token.value == "option" || token.value == "reserved" || token.value == "extensions":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+			continue
+		case token.value == "extend":
+			p.pos++
+			if _, err := p.fullIdent(); err != nil {
+				return err
+			}
+			if err := p.skipBlock(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		field, err := p.field(message)
+		if err != nil {
+			return err
+		}
+		field.oneof = oneof
+		field.description = firstNonEmpty(token.comment, field.description)
+		message.fields = append(message.fields, field)
+	}
+	return nil
+}
+
+// field reads [label] type name = number [options];, or a proto2 group
+func (p *protoParser) field(message *protoMessage) (protoField, error) {
+	var field protoField
+	if token := p.peek(); token.kind == protoIdent {
+		switch token.value {
+		case "repeated", "optional", "required":
+			// a field whose type is named like a label is followed by its name
+			if next := p.tokens[p.pos+1]; next.kind == protoIdent || next.is(".") {
+				field.label = token.value
+				p.pos++
+			}
+		}
+	}
+
+	var err error
+	if p.peek().kind == protoIdent && p.peek().value == "map" && p.tokens[p.pos+1].is("<") {
+		p.pos += 2
+		key, err := p.ident()
+		if err != nil {
+			return field, err
+		}
+		if err := p.expect(","); err != nil {
+			return field, err
+		}
+		value, err := p.fullIdent()
+		if err != nil {
+			return field, err
+		}
+		if err := p.expect(">"); err != nil {
+			return field, err
+		}
+		field.typ = "map<" + key + ", " + value + ">"
+	} else if field.typ, err = p.fullIdent(); err != nil {
+		return field, err
+	}
+
+	if field.name, err = p.ident(); err != nil {
+		return field, err
+	}
+	if err := p.expect("="); err != nil {
+		return field, err
+	}
+	if token := p.next(); token.kind != protoNumber {
+		return field, p.errorAt(token, "expected a field number")
+	}
+	if p.peek().is("[") {
+		if err := p.skipBracketed("[", "]"); err != nil {
+			return field, err
+		}
+	}
+
+	if field.typ == "group" {
+		// proto2 groups declare a nested message named like the field
+		group := &protoMessage{kind: "message", name: qualify(message.name, field.name)}
+		p.schema.messages[group.name] = group
+		p.schema.order = append(p.schema.order, group.name)
+		if err := p.messageBody(group, ""); err != nil {
+			return field, err
+		}
+		field.typ, field.name = field.name, strings.ToLower(field.name)
+		return field, nil
+	}
+
+	end := p.peek()
+	if err := p.expect(";"); err != nil {
+		return field, err
+	}
+	field.description = end.trailing
+	return field, nil
+}
+
+func (p *protoParser) enumBody(message *protoMessage) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.skip("}") {
+		if p.skip(";") {
+			continue
+		}
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if name == "option" || name == "reserved" {
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+			continue
+		}
+		message.values = append(message.values, name)
+		if err := p.skipStatement(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) service(description string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	service := &protoService{name: qualify(p.pkg, name), scope: p.pkg, description: description}
+	p.schema.services = append(p.schema.services, service)
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.skip("}") {
+		if p.skip(";") {
+			continue
+		}
+		token := p.peek()
+		keyword, err := p.ident()
+		if err != nil {
+			return err
+		}
+		switch keyword {
+		case "option":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+			continue
+		case "rpc":
+		default:
+			return p.errorAt(token, "expected rpc")
+		}
+
+		method := models.GRPCMethod{Description: token.comment}
+		if method.Name, err = p.ident(); err != nil {
+			return err
+		}
+		if method.Request, method.ClientStreaming, err = p.messageType(); err != nil {
+			return err
+		}
+		if token := p.next(); token.kind != protoIdent || token.value != "returns" {
+			return p.errorAt(token, "expected returns")
+		}
+		if method.Response, method.ServerStreaming, err = p.messageType(); err != nil {
+			return err
+		}
+
+		if p.skip("{") {
+			for !p.skip("}") {
+				if p.peek().kind == protoEOF {
+					return p.errorf("expected \"}\"")
+				}
+				if p.isOption("deprecated", "true") {
+					method.Deprecated = true
+				}
+				if err := p.skipStatement(); err != nil {
+					return err
+				}
+			}
+		} else if err := p.expect(";"); err != nil {
+			return err
+		}
+		service.methods = append(service.methods, method)
+	}
+	return nil
+}
+
+// messageType reads ([stream] Type) of an rpc
+func (p *protoParser) messageType() (name string, stream bool, err error) {
+	if err := p.expect("("); err != nil {
+		return "", false, err
+	}
+	if token := p.peek(); token.kind == protoIdent && token.value == "stream" && !p.tokens[p.pos+1].is(")") {
+		stream = true
+		p.pos++
+	}
+	if name, err = p.fullIdent(); err != nil {
+		return "", false, err
+	}
+	return name, stream, p.expect(")")
+}
+
+// isOption reports whether the next statement is option name = value;
+func (p *protoParser) isOption(name, value string) bool {
+	if p.pos+4 >= len(p.tokens) {
+		return false
+	}
+	option := p.tokens[p.pos : p.pos+4]
+	return option[0].value == "option" && option[1].value == name && option[2].is("=") && option[3].value == value
+}
+
+// skipStatement skips to the end of a statement, past any nested blocks
+// such as aggregate option values
+func (p *protoParser) skipStatement() error {
+	depth := 0
+	for {
+		token := p.next()
+		switch {
+		case token.kind == protoEOF:
+			return p.errorAt(token, `expected ";"`)
+		case token.is("{"), token.is("["), token.is("("):
+			depth++
+		case token.is("}"), token.is("]"), token.is(")"):
+			depth--
+		case token.is(";") && depth == 0:
+			return nil
+		}
+	}
+}
+
+// skipBlock skips a { ... } block
+func (p *protoParser) skipBlock() error {
+	return p.skipBracketed("{", "}")
+}
+
+func (p *protoParser) skipBracketed(open, close string) error {
+	if err := p.expect(open); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; {
+		token := p.next()
+		switch {
+		case token.kind == protoEOF:
+			return p.errorAt(token, "expected "+strconv.Quote(close))
+		case token.is(open):
+			depth++
+		case token.is(close):
+			depth--
+		}
+	}
+	return nil
+}
+
+// fullIdent reads a possibly qualified name such as .pets.v1.Pet
+func (p *protoParser) fullIdent() (string, error) {
+	var name string
+	if p.skip(".") {
+		name = "."
+	}
+	for {
+		part, err := p.ident()
+		if err != nil {
+			return "", err
+		}
+		name += part
+		if !p.skip(".") {
+			return name, nil
+		}
+		name += "."
+	}
+}
+
+func (p *protoParser) peek() protoToken {
+	return p.tokens[p.pos]
+}
+
+func (p *protoParser) next() protoToken {
+	token := p.tokens[p.pos]
+	if token.kind != protoEOF {
+		p.pos++
+	}
+	return token
+}
+
+// skip consumes the punctuator if it's next
+func (p *protoParser) skip(punct string) bool {
+	if p.peek().is(punct) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *protoParser) expect(punct string) error {
+	if !p.skip(punct) {
+		return p.errorf("expected %q", punct)
+	}
+	return nil
+}
+
+func (p *protoParser) ident() (string, error) {
+	token := p.peek()
+	if token.kind != protoIdent {
+		return "", p.errorf("expected a name")
+	}
+	p.pos++
+	return token.value, nil
+}
+
+func (p *protoParser) errorf(format string, args ...interface{}) error {
+	return p.errorAt(p.peek(), fmt.Sprintf(format, args...))
+}
+
+func (p *protoParser) errorAt(token protoToken, message string) error {
+	if token.kind == protoEOF {
+		return fmt.Errorf("line %d: %s, got end of file", token.line, message)
+	}
+	return fmt.Errorf("line %d: %s, got %q", token.line, message, token.value)
+}
+
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+type protoTokenKind int
+
+const (
+	protoEOF protoTokenKind = iota
+	protoIdent
+	protoPunct
+	protoString
+	protoNumber
+)
+
+type protoToken struct {
+	kind     protoTokenKind
+	value    string
+	line     int
+	comment  string // comment lines directly above the token
+	trailing string // comment following the token on the same line
+}
+
+func (t protoToken) is(punct string) bool {
+	return t.kind == protoPunct && t.value == punct
+}
+
+// lexProto splits .proto source into tokens. Comments are attached to the
+// token they document: the one on the following line, or the one they
+// trail on the same line. A blank line detaches a comment.
+func lexProto(src string) ([]protoToken, error) {
+	var tokens []protoToken
+	var pending []string
+	line := 1
+	emit := func(token protoToken) {
+		token.comment = flatten(strings.Join(pending, "\n"))
+		pending = nil
+		tokens = append(tokens, token)
+	}
+	comment := func(text string, startLine int) {
+		if len(tokens) > 0 && tokens[len(tokens)-1].line == startLine && len(pending) == 0 {
+			tokens[len(tokens)-1].trailing = flatten(text)
+			return
+		}
+		pending = append(pending, text)
+	}
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			// a line holding nothing but whitespace detaches pending comments
+			if rest := strings.TrimLeft(src[i:], " \t\r"); strings.HasPrefix(rest, "\n") {
+				pending = nil
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		case strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				end = len(src) - i
+			}
+			comment(strings.TrimPrefix(src[i+2:i+end], "/"), line)
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			text := src[i+2 : i+2+end]
+			var lines []string
+			for _, l := range strings.Split(text, "\n") {
+				lines = append(lines, strings.TrimPrefix(strings.TrimSpace(l), "*"))
+			}
+			comment(strings.Join(lines, "\n"), line)
+			line += strings.Count(text, "\n")
+			i += end + 4
+		case c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+			start := i
+			for i < len(src) && isNameChar(src[i]) {
+				i++
+			}
+			emit(protoToken{kind: protoIdent, value: src[start:i], line: line})
+		case c >= '0' && c <= '9' || c == '-' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			start := i
+			i++
+			for i < len(src) && (isNameChar(src[i]) || src[i] == '.' || (src[i] == '+' || src[i] == '-') && (src[i-1] == 'e' || src[i-1] == 'E')) {
+				i++
+			}
+			emit(protoToken{kind: protoNumber, value: src[start:i], line: line})
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(src) && src[end] != c && src[end] != '\n' {
+				if src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(src) || src[end] != c {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			emit(protoToken{kind: protoString, value: src[i+1 : end], line: line})
+			i = end + 1
+		case strings.ContainsRune(";,.=:-+(){}[]<>/", rune(c)):
+			emit(protoToken{kind: protoPunct, value: string(c), line: line})
+			i++
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	emit(protoToken{kind: protoEOF, line: line})
+	return tokens, nil
+}
@@ -0,0 +1,192 @@
+package importer
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+const petstoreProto = `// Copyright header, detached by the blank line.
+
+syntax = "proto3";
+
+package pets.v1;
+
+import "google/protobuf/timestamp.proto";
+
+option go_package = "example.com/pets/v1;petsv1";
+
+// PetService manages the pets
+// in the store.
+service PetService {
+  option (google.api.default_host) = "pets.example.com";
+
+  // Get a pet by its ID
+  rpc GetPet(GetPetRequest) returns (Pet);
+  /* Stream pets as they're added */
+  rpc WatchPets(WatchPetsRequest) returns (stream Pet) {}
+  rpc Upload(stream Chunk) returns (UploadSummary) {
+    option deprecated = true;
+    option (google.api.http) = { post: "/v1/upload" body: "*" };
+  }
+  rpc Chat(stream .pets.v1.Chunk) returns (stream Chunk);
+}
+
+message GetPetRequest {
+  string id = 1; // the pet's ID
+}
+
+// A pet in the store
+message Pet {
+  string id = 1;
+  string name = 2 [json_name = "displayName"];
+  Kind kind = 3;
+  repeated string tags = 4;
+  map<string, Owner> owners = 5;
+  google.protobuf.Timestamp created_at = 6;
+  oneof adoption {
+    string shelter = 7;
+    // when the pet was adopted
+    google.protobuf.Timestamp adopted_at = 8;
+  }
+  reserved 9, 10;
+
+  enum Kind {
+    KIND_UNSPECIFIED = 0;
+    DOG = 1;
+    CAT = 2 [deprecated = true];
+  }
+  message Owner {
+    string name = 1;
+  }
+}
+
+message WatchPetsRequest { optional Pet.Kind kind = 1; }
+message Chunk { bytes data = 1; }
+message UploadSummary { int64 size = 1; }
+message Unused { string x = 1; }
+`
+
+func TestImportProto(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pets.proto")
+	writeFile(t, path, petstoreProto)
+
+	docs, err := ImportProto(path)
+	if err != nil {
+		t.Fatalf("ImportProto failed: %v", err)
+	}
+	if docs.Proto != path {
+		t.Errorf("expected proto path %q, got %q", path, docs.Proto)
+	}
+
+	if len(docs.Services) != 1 || len(docs.Services[0].Methods) != 4 {
+		t.Fatalf("unexpected services: %+v", docs.Services)
+	}
+	service := docs.Services[0]
+	if service.Name != "pets.v1.PetService" || service.Description != "PetService manages the pets in the store." {
+		t.Errorf("unexpected service: %+v", service)
+	}
+
+	getPet := service.Methods[0]
+	if getPet.Request != "pets.v1.GetPetRequest" || getPet.Response != "pets.v1.Pet" || getPet.Description != "Get a pet by its ID" {
+		t.Errorf("unexpected GetPet: %+v", getPet)
+	}
+	if getPet.ClientStreaming || getPet.ServerStreaming {
+		t.Errorf("expected GetPet to be unary: %+v", getPet)
+	}
+	if watch := service.Methods[1]; !watch.ServerStreaming || watch.ClientStreaming || watch.Description != "Stream pets as they're added" {
+		t.Errorf("unexpected WatchPets: %+v", watch)
+	}
+	if upload := service.Methods[2]; !upload.ClientStreaming || upload.ServerStreaming || !upload.Deprecated {
+		t.Errorf("unexpected Upload: %+v", upload)
+	}
+	if chat := service.Methods[3]; !chat.ClientStreaming || !chat.ServerStreaming || chat.Request != "pets.v1.Chunk" {
+		t.Errorf("unexpected Chat: %+v", chat)
+	}
+
+	var names []string
+	for _, message := range docs.Messages {
+		names = append(names, message.Kind+" "+message.Name)
+	}
+	want := "message pets.v1.GetPetRequest, message pets.v1.Pet, enum pets.v1.Pet.Kind, message pets.v1.Pet.Owner, " +
+		"message pets.v1.WatchPetsRequest, message pets.v1.Chunk, message pets.v1.UploadSummary"
+	if strings.Join(names, ", ") != want {
+		t.Errorf("expected reachable messages %s, got %s", want, strings.Join(names, ", "))
+	}
+
+	if id := docs.Messages[0].Fields[0]; id.Description != "the pet's ID" {
+		t.Errorf("expected a trailing comment description, got %+v", id)
+	}
+	pet := docs.Messages[1]
+	if pet.Description != "A pet in the store" || len(pet.Fields) != 8 {
+		t.Fatalf("unexpected Pet: %+v", pet)
+	}
+	for i, want := range []string{
+		"id string  ",
+		"name string  ",
+		"kind pets.v1.Pet.Kind  ",
+		"tags string repeated ",
+		"owners map<string, pets.v1.Pet.Owner>  ",
+		"created_at google.protobuf.Timestamp  ",
+		"shelter string  adoption",
+		"adopted_at google.protobuf.Timestamp  adoption",
+	} {
+		field := pet.Fields[i]
+		if got := strings.Join([]string{field.Name, field.Type, field.Label, field.Oneof}, " "); got != want {
+			t.Errorf("field %d: expected %q, got %q", i, want, got)
+		}
+	}
+	if adopted := pet.Fields[7]; adopted.Description != "when the pet was adopted" {
+		t.Errorf("unexpected adopted_at description: %q", adopted.Description)
+	}
+	if kind := docs.Messages[2]; strings.Join(kind.Values, ",") != "KIND_UNSPECIFIED,DOG,CAT" {
+		t.Errorf("unexpected enum values: %v", kind.Values)
+	}
+	if watch := docs.Messages[4].Fields[0]; watch.Type != "pets.v1.Pet.Kind" || watch.Label != "optional" {
+		t.Errorf("unexpected WatchPetsRequest field: %+v", watch)
+	}
+
+	if err := validation.ValidateStruct(docs); err != nil {
+		t.Errorf("expected imported gRPC docs to validate: %v", err)
+	}
+}
+
+func TestImportProto_SeveralFiles(t *testing.T) {
+	dir := t.TempDir()
+	service := filepath.Join(dir, "service.proto")
+	messages := filepath.Join(dir, "messages.proto")
+	writeFile(t, service, "syntax = \"proto3\";\npackage health;\nservice Health {\n  rpc Check(CheckRequest) returns (CheckResponse);\n}\n")
+	writeFile(t, messages, "syntax = \"proto3\";\npackage health;\nmessage CheckRequest {}\nmessage CheckResponse { bool ok = 1; }\n")
+
+	docs, err := ImportProto(service, messages)
+	if err != nil {
+		t.Fatalf("ImportProto failed: %v", err)
+	}
+	if len(docs.Messages) != 2 || docs.Services[0].Methods[0].Request != "health.CheckRequest" {
+		t.Errorf("expected messages resolved across files, got %+v", docs)
+	}
+	if docs.Proto != dir {
+		t.Errorf("expected the proto directory for several files, got %q", docs.Proto)
+	}
+}
+
+func TestImportProto_Errors(t *testing.T) {
+	tests := map[string]string{
+		"no services":  "syntax = \"proto3\";\nmessage Pet { string id = 1; }",
+		"no returns":   "service S { rpc Get(A) (B); }",
+		"bad field":    "message Pet { string id; }",
+		"unterminated": "message Pet { string id = 1;",
+		"bad string":   "syntax = \"proto3;",
+	}
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "pets.proto")
+			writeFile(t, path, src)
+			if _, err := ImportProto(path); err == nil {
+				t.Errorf("expected an error for %q", src)
+			}
+		})
+	}
+}
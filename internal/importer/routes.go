@@ -0,0 +1,631 @@
+package importer
+
+import (
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+// routeMethods maps registration methods of gin, echo, chi and fiber
+// routers to HTTP methods
+var routeMethods = map[string]string{
+	"GET": "GET", "POST": "POST", "PUT": "PUT", "DELETE": "DELETE", "PATCH": "PATCH", "OPTIONS": "OPTIONS", "HEAD": "HEAD",
+	"Get": "GET", "Post": "POST", "Put": "PUT", "Delete": "DELETE", "Patch": "PATCH", "Options": "OPTIONS", "Head": "HEAD",
+}
+
+// routerPackage lists the router types and constructors of a package
+type routerPackage struct {
+	types        []string
+	constructors []string
+}
+
+// routerPackages are keyed by import path without a major version suffix.
+// Only calls on values of these types register routes, so cache.Get("/key", &v)
+// isn't mistaken for one.
+var routerPackages = map[string]routerPackage{
+	"github.com/gin-gonic/gin": {types: []string{"Engine", "RouterGroup", "IRouter", "IRoutes"}, constructors: []string{"Default", "New"}},
+	"github.com/labstack/echo": {types: []string{"Echo", "Group"}, constructors: []string{"New"}},
+	"github.com/go-chi/chi":    {types: []string{"Router", "Mux"}, constructors: []string{"NewRouter", "NewMux"}},
+	"github.com/gofiber/fiber": {types: []string{"App", "Router", "Group"}, constructors: []string{"New"}},
+	"github.com/gorilla/mux":   {types: []string{"Router"}, constructors: []string{"NewRouter"}},
+	"net/http":                 {types: []string{"ServeMux"}, constructors: []string{"NewServeMux"}},
+}
+
+var (
+	// :id, *path, {id}, {id:[0-9]+} and {path...}
+	pathParamPattern = regexp.MustCompile(`:(\w+)|\*(\w+)|\{(\w+)(?::[^}]*|\.\.\.)?\}`)
+	// Go 1.22 ServeMux patterns such as "GET /users/{id}"
+	muxPatternPattern = regexp.MustCompile(`^(?:([A-Z]+)\s+)?[^/]*(/.*)$`)
+)
+
+// funcKey names a function by the directory of its package and, for
+// methods, its receiver type
+type funcKey struct {
+	dir  string // empty for a method whose receiver type isn't known
+	recv string
+	name string
+}
+
+// route is a registration found in the source
+type route struct {
+	endpoint models.Endpoint
+	handlers []funcKey // the functions the route is given
+	comment  string    // comment on the line above the registration
+}
+
+// goFile is a parsed file and the directory of its package
+type goFile struct {
+	file *ast.File
+	dir  string
+}
+
+// routeScanner carries the path prefixes of router groups through a
+// function body
+type routeScanner struct {
+	fset     *token.FileSet
+	dir      string
+	comments map[int]string
+	routes   []route
+	handled  map[*ast.CallExpr]bool
+
+	routerImports map[string]routerPackage // local import name -> router package
+	httpName      string                   // local name of net/http
+	packageDirs   map[string]string        // local import name -> directory of a package in dir
+	routerFields  map[string]bool          // package-level variables and struct fields holding routers
+	types         map[string]funcKey       // receiver and parameter names -> their type, in the current function
+}
+
+// GoRoutes scans the Go source under dir for HTTP route registrations and
+// proposes an endpoint for each. It recognises gin and echo (r.GET), chi and
+// fiber (r.Get, r.Route, r.Method), gorilla/mux (HandleFunc(...).Methods)
+// and net/http (http.HandleFunc, mux.Handle, including Go 1.22 method
+// patterns), on values created by or typed as those packages' routers.
+// Prefixes from Group, Route and PathPrefix(...).Subrouter() are applied,
+// path parameters are written as {name} and become parameters, and handler
+// doc comments become descriptions. Routes that accept any method, such as
+// HandleFunc without a method in its pattern, get an empty method for the
+// user to fill in.
+func GoRoutes(dir string) ([]models.Endpoint, error) {
+	var (
+		fset  *token.FileSet
+		files []goFile
+	)
+	err := walkGoFiles(dir, func(fileSet *token.FileSet, file *ast.File) {
+		fset = fileSet
+		files = append(files, goFile{file: file, dir: filepath.Dir(fset.Position(file.Package).Filename)})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// first collect the documented functions and the routers kept in
+	// variables and struct fields, which may be declared in other files
+	docs := make(map[funcKey]string)
+	methods := make(map[string][]funcKey) // documented methods by name
+	routerFields := make(map[string]map[string]bool)
+	dirs := make(map[string]bool)
+	for _, f := range files {
+		dirs[f.dir] = true
+		if routerFields[f.dir] == nil {
+			routerFields[f.dir] = make(map[string]bool)
+		}
+		imports := routerImports(f.file)
+		collectRouterFields(f.file, imports, routerFields[f.dir])
+		for _, decl := range f.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil {
+				continue
+			}
+			key := funcKey{dir: f.dir, recv: receiverType(fn), name: fn.Name.Name}
+			docs[key] = firstSentence(fn.Name.Name, fn.Doc.Text())
+			if key.recv != "" {
+				methods[key.name] = append(methods[key.name], key)
+			}
+		}
+	}
+	resolve := packageResolver(dir, dirs)
+
+	var routes []route
+	for _, f := range files {
+		scanner := &routeScanner{
+			fset:          fset,
+			dir:           f.dir,
+			comments:      commentsByEndLine(fset, f.file),
+			handled:       make(map[*ast.CallExpr]bool),
+			routerImports: routerImports(f.file),
+			httpName:      importName(f.file, "net/http"),
+			packageDirs:   make(map[string]string),
+			routerFields:  routerFields[f.dir],
+		}
+		for _, imp := range f.file.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			if pkgDir, ok := resolve(path); ok {
+				name := defaultImportName(path)
+				if imp.Name != nil {
+					name = imp.Name.Name
+				}
+				scanner.packageDirs[name] = pkgDir
+			}
+		}
+		for _, decl := range f.file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				scanner.scanFunc(fn)
+			}
+		}
+		routes = append(routes, scanner.routes...)
+	}
+
+	var endpoints []models.Endpoint
+	seen := make(map[string]bool)
+	for _, r := range routes {
+		key := r.endpoint.Method + " " + r.endpoint.Path
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		// the first documented handler, as middleware may come before or after it
+		endpoint := r.endpoint
+		for _, handler := range r.handlers {
+			if handler.dir == "" {
+				// a method of an unknown type, only when the name is unambiguous
+				if keys := methods[handler.name]; len(keys) == 1 {
+					handler = keys[0]
+				}
+			}
+			if endpoint.Description = docs[handler]; endpoint.Description != "" {
+				break
+			}
+		}
+		endpoint.Description = truncate(firstNonEmpty(endpoint.Description, r.comment), maxEndpointDescription)
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+// routerImports returns the router packages a file imports by local name
+func routerImports(file *ast.File) map[string]routerPackage {
+	imports := make(map[string]routerPackage)
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		base := path
+		if i := strings.LastIndex(path, "/"); i >= 0 && majorVersionPattern.MatchString(path[i+1:]) {
+			base = path[:i]
+		}
+		pkg, ok := routerPackages[base]
+		if !ok {
+			continue
+		}
+		name := defaultImportName(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = pkg
+	}
+	return imports
+}
+
+// collectRouterFields records the package-level variables and struct fields
+// that hold routers: declared with a router type, or assigned a router
+// constructor as in s.router = mux.NewRouter()
+func collectRouterFields(file *ast.File, imports map[string]routerPackage, fields map[string]bool) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			// local variables are tracked while scanning
+			if n.Body != nil {
+				ast.Inspect(n.Body, func(n ast.Node) bool {
+					if assign, ok := n.(*ast.AssignStmt); ok {
+						for i, lhs := range assign.Lhs {
+							if sel, ok := lhs.(*ast.SelectorExpr); ok && i < len(assign.Rhs) && isRouterConstructor(assign.Rhs[i], imports) {
+								fields[sel.Sel.Name] = true
+							}
+						}
+					}
+					return true
+				})
+			}
+			return false
+		case *ast.Field:
+			if isRouterType(n.Type, imports) {
+				for _, name := range n.Names {
+					fields[name.Name] = true
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if isRouterType(n.Type, imports) || i < len(n.Values) && isRouterConstructor(n.Values[i], imports) {
+					fields[name.Name] = true
+				}
+			}
+		}
+		return true
+	})
+}
+
+// isRouterType reports whether a type expression is one of the router types
+func isRouterType(expr ast.Expr, imports map[string]routerPackage) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := imports[identName(sel.X)]
+	return ok && slices.Contains(pkg.types, sel.Sel.Name)
+}
+
+// isRouterConstructor reports whether an expression is a call such as
+// gin.Default() or chi.NewRouter()
+func isRouterConstructor(expr ast.Expr, imports map[string]routerPackage) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := imports[identName(sel.X)]
+	return ok && slices.Contains(pkg.constructors, sel.Sel.Name)
+}
+
+func identName(expr ast.Expr) string {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// receiverType returns the type name of a method's receiver, or "" for a
+// function
+func receiverType(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch expr := expr.(type) {
+	case *ast.IndexExpr:
+		return identName(expr.X)
+	case *ast.IndexListExpr:
+		return identName(expr.X)
+	}
+	return identName(expr)
+}
+
+// packageResolver finds the directory under root of an imported package,
+// using the module path in root's go.mod, or else the longest directory
+// path the import path ends with
+func packageResolver(root string, dirs map[string]bool) func(path string) (string, bool) {
+	module := ""
+	if data, err := os.ReadFile(filepath.Join(root, "go.mod")); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+				module = strings.Trim(strings.TrimSpace(rest), `"`)
+				break
+			}
+		}
+	}
+
+	return func(path string) (string, bool) {
+		if module != "" {
+			rel, ok := strings.CutPrefix(path, module)
+			if !ok || rel != "" && !strings.HasPrefix(rel, "/") {
+				return "", false
+			}
+			dir := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(rel, "/")))
+			return dir, dirs[dir]
+		}
+		best := ""
+		for dir := range dirs {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil || rel == "." {
+				continue
+			}
+			rel = filepath.ToSlash(rel)
+			if (path == rel || strings.HasSuffix(path, "/"+rel)) && len(dir) > len(best) {
+				best = dir
+			}
+		}
+		return best, best != ""
+	}
+}
+
+// scanFunc scans a function declaration, starting from the routers and
+// types of its receiver and parameters
+func (s *routeScanner) scanFunc(fn *ast.FuncDecl) {
+	prefixes := map[string]string{}
+	s.types = map[string]funcKey{}
+	if fn.Recv != nil {
+		for _, field := range fn.Recv.List {
+			for _, name := range field.Names {
+				s.types[name.Name] = funcKey{dir: s.dir, recv: receiverType(fn)}
+			}
+		}
+	}
+	s.addParams(fn.Type, prefixes)
+	s.scan(fn.Body, prefixes)
+}
+
+// addParams tracks the router parameters of a function and the types of
+// the others
+func (s *routeScanner) addParams(fn *ast.FuncType, prefixes map[string]string) {
+	for _, field := range fn.Params.List {
+		for _, name := range field.Names {
+			if isRouterType(field.Type, s.routerImports) {
+				prefixes[name.Name] = ""
+			} else if typ, ok := s.typeKey(field.Type); ok {
+				s.types[name.Name] = typ
+			}
+		}
+	}
+}
+
+// typeKey locates a named type such as Handlers, *Handlers or
+// *handlers.Users in the scanned packages
+func (s *routeScanner) typeKey(expr ast.Expr) (funcKey, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return funcKey{dir: s.dir, recv: expr.Name}, true
+	case *ast.SelectorExpr:
+		if dir, ok := s.packageDirs[identName(expr.X)]; ok {
+			return funcKey{dir: dir, recv: expr.Sel.Name}, true
+		}
+	}
+	return funcKey{}, false
+}
+
+// scan walks a function body, tracking the prefix of each router variable
+func (s *routeScanner) scan(body ast.Node, prefixes map[string]string) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			// v1 := r.Group("/v1") or api := r.PathPrefix("/api").Subrouter()
+			for i, rhs := range n.Rhs {
+				if i >= len(n.Lhs) {
+					break
+				}
+				ident, ok := n.Lhs[i].(*ast.Ident)
+				if !ok {
+					continue
+				}
+				if prefix, ok := s.groupPrefix(rhs, prefixes); ok {
+					prefixes[ident.Name] = prefix
+				}
+			}
+		case *ast.FuncLit:
+			// register := func(r chi.Router) { ... }
+			s.addParams(n.Type, prefixes)
+		case *ast.CallExpr:
+			return s.scanCall(n, prefixes)
+		}
+		return true
+	})
+}
+
+func (s *routeScanner) scanCall(call *ast.CallExpr, prefixes map[string]string) bool {
+	if s.handled[call] {
+		return true
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return true
+	}
+	prefix, isRouter := s.routerPrefix(sel.X, prefixes)
+
+	switch name := sel.Sel.Name; {
+	case name == "Methods":
+		// gorilla/mux: r.HandleFunc("/users", h).Methods("GET", "POST")
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		innerSel, ok := inner.Fun.(*ast.SelectorExpr)
+		if !ok || (innerSel.Sel.Name != "HandleFunc" && innerSel.Sel.Name != "Handle") {
+			return true
+		}
+		innerPrefix, ok := s.routerPrefix(innerSel.X, prefixes)
+		path, isPath := stringArg(inner, 0)
+		if !ok || !isPath || len(inner.Args) < 2 {
+			return true
+		}
+		for _, arg := range call.Args {
+			if method, ok := constString(arg); ok {
+				s.add(inner, strings.ToUpper(method), innerPrefix+path, inner.Args[1:])
+			}
+		}
+		s.handled[inner] = true
+	case name == "HandleFunc" || name == "Handle":
+		// net/http and mux routers; without a method in the pattern the
+		// route accepts any
+		if !isRouter && (s.httpName == "" || identName(sel.X) != s.httpName) {
+			return true
+		}
+		pattern, ok := stringArg(call, 0)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		match := muxPatternPattern.FindStringSubmatch(pattern)
+		if match == nil {
+			return true
+		}
+		s.add(call, match[1], prefix+match[2], call.Args[1:])
+	case !isRouter:
+		return true
+	case name == "Route" || name == "Group":
+		// chi: r.Route("/api", func(r chi.Router) { ... })
+		if len(call.Args) == 0 {
+			return true
+		}
+		routePrefix, _ := stringArg(call, 0)
+		fn, ok := call.Args[len(call.Args)-1].(*ast.FuncLit)
+		if !ok || len(fn.Type.Params.List) == 0 || len(fn.Type.Params.List[0].Names) == 0 {
+			return true
+		}
+		inner := make(map[string]string, len(prefixes)+1)
+		for k, v := range prefixes {
+			inner[k] = v
+		}
+		inner[fn.Type.Params.List[0].Names[0].Name] = prefix + routePrefix
+		s.scan(fn.Body, inner)
+		return false
+	case name == "Method" || name == "MethodFunc":
+		// chi: r.Method("GET", "/users", h)
+		method, ok := stringArg(call, 0)
+		path, isPath := stringArg(call, 1)
+		if ok && isPath && len(call.Args) == 3 {
+			s.add(call, strings.ToUpper(method), prefix+path, call.Args[2:])
+		}
+	case routeMethods[name] != "":
+		path, ok := stringArg(call, 0)
+		if !ok || !strings.HasPrefix(path, "/") && path != "" || len(call.Args) < 2 {
+			return true
+		}
+		s.add(call, routeMethods[name], prefix+path, call.Args[1:])
+	}
+	return true
+}
+
+// groupPrefix matches calls that create a router: constructors, and
+// prefixed groups of another router
+func (s *routeScanner) groupPrefix(expr ast.Expr, prefixes map[string]string) (string, bool) {
+	if isRouterConstructor(expr, s.routerImports) {
+		return "", true
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+
+	switch sel.Sel.Name {
+	case "Group", "PathPrefix":
+		prefix, ok := stringArg(call, 0)
+		if !ok {
+			return "", false
+		}
+		routerPrefix, ok := s.routerPrefix(sel.X, prefixes)
+		return routerPrefix + prefix, ok
+	case "Subrouter":
+		return s.groupPrefix(sel.X, prefixes)
+	case "With":
+		// chi: r.With(middleware).Get(...)
+		return s.routerPrefix(sel.X, prefixes)
+	}
+	return "", false
+}
+
+// routerPrefix reports whether an expression is a router and returns its
+// path prefix
+func (s *routeScanner) routerPrefix(expr ast.Expr, prefixes map[string]string) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return s.routerPrefix(expr.X, prefixes)
+	case *ast.Ident:
+		if prefix, ok := prefixes[expr.Name]; ok {
+			return prefix, true
+		}
+		return "", s.routerFields[expr.Name]
+	case *ast.SelectorExpr:
+		// s.router, a struct field holding a router
+		return "", s.routerFields[expr.Sel.Name]
+	case *ast.CallExpr:
+		return s.groupPrefix(expr, prefixes)
+	}
+	return "", false
+}
+
+// add records an endpoint, turning its path parameters into parameters
+func (s *routeScanner) add(call *ast.CallExpr, method, path string, handlers []ast.Expr) {
+	path = cleanRoutePath(path)
+
+	endpoint := models.Endpoint{Method: method, Path: path}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		name := firstNonEmpty(match[1], match[2], match[3])
+		endpoint.Parameters = append(endpoint.Parameters, models.Parameter{
+			Name:        name,
+			In:          "path",
+			Type:        "string",
+			Required:    true,
+			Description: name + " path parameter",
+		})
+	}
+
+	r := route{
+		endpoint: endpoint,
+		comment:  s.comments[s.fset.Position(call.Pos()).Line-1],
+	}
+	for _, handler := range handlers {
+		if key, ok := s.handlerKey(handler); ok {
+			r.handlers = append(r.handlers, key)
+		}
+	}
+	s.routes = append(s.routes, r)
+}
+
+// cleanRoutePath joins prefixes and writes every path parameter as {name}
+func cleanRoutePath(path string) string {
+	path = "/" + strings.TrimLeft(strings.ReplaceAll(path, "//", "/"), "/")
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return pathParamPattern.ReplaceAllStringFunc(path, func(param string) string {
+		match := pathParamPattern.FindStringSubmatch(param)
+		return "{" + firstNonEmpty(match[1], match[2], match[3]) + "}"
+	})
+}
+
+// handlerKey finds the function behind handler expressions such as
+// listUsers, users.List, h.ListUsers or http.HandlerFunc(listUsers). A
+// method of a value whose type isn't known has an empty dir.
+func (s *routeScanner) handlerKey(expr ast.Expr) (funcKey, bool) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return funcKey{dir: s.dir, name: expr.Name}, true
+	case *ast.SelectorExpr:
+		x := identName(expr.X)
+		if dir, ok := s.packageDirs[x]; ok {
+			return funcKey{dir: dir, name: expr.Sel.Name}, true
+		}
+		if typ, ok := s.types[x]; ok {
+			typ.name = expr.Sel.Name
+			return typ, true
+		}
+		return funcKey{name: expr.Sel.Name}, true
+	case *ast.CallExpr:
+		if len(expr.Args) == 1 {
+			return s.handlerKey(expr.Args[0])
+		}
+	}
+	return funcKey{}, false
+}
+
+// firstSentence returns the first sentence of a doc comment, dropping the
+// function name Go doc comments start with
+func firstSentence(name, doc string) string {
+	text := flatten(doc)
+	if i := strings.Index(text, ". "); i >= 0 {
+		text = text[:i+1]
+	}
+	if rest, ok := strings.CutPrefix(text, name+" "); ok && rest != "" {
+		text = strings.ToUpper(rest[:1]) + rest[1:]
+	}
+	return text
+}
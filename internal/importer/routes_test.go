@@ -0,0 +1,178 @@
+package importer
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestGoRoutes(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "gin.go"), `package main
+
+import "github.com/gin-gonic/gin"
+
+func setupGin() {
+	r := gin.Default()
+	v1 := r.Group("/v1")
+	v1.GET("/users/:id", auth, getUser)
+	r.POST("/login", login)
+}
+
+// getUser returns a single user. It needs a token.
+func getUser(c *gin.Context) {}
+`)
+	writeFile(t, filepath.Join(dir, "chi.go"), `package main
+
+import "github.com/go-chi/chi/v5"
+
+func setupChi(h *Handlers) {
+	r := chi.NewRouter()
+	r.Route("/orders", func(r chi.Router) {
+		// List all orders
+		r.Get("/", h.ListOrders)
+		r.Delete("/{orderID:[0-9]+}", h.DeleteOrder)
+	})
+	r.Method("PUT", "/settings", h.Settings)
+}
+
+// DeleteOrder cancels an order.
+func (h *Handlers) DeleteOrder() {}
+`)
+	writeFile(t, filepath.Join(dir, "std.go"), `package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+func setupStd() {
+	std := http.NewServeMux()
+	std.HandleFunc("GET /files/{path...}", serveFile)
+	http.Handle("/health", http.HandlerFunc(health))
+
+	r := mux.NewRouter()
+	api := r.PathPrefix("/api").Subrouter()
+	api.HandleFunc("/items", items).Methods("GET", "POST")
+	api.HandleFunc("/items", items).Methods("GET")
+}
+
+// health reports whether the service is up.
+func health(w http.ResponseWriter, r *http.Request) {}
+`)
+
+	endpoints, err := GoRoutes(dir)
+	if err != nil {
+		t.Fatalf("GoRoutes failed: %v", err)
+	}
+
+	want := []struct {
+		method, path, description string
+		parameters                []string
+	}{
+		{"GET", "/orders", "List all orders", nil},
+		{"DELETE", "/orders/{orderID}", "Cancels an order.", []string{"orderID"}},
+		{"PUT", "/settings", "", nil},
+		{"GET", "/v1/users/{id}", "Returns a single user.", []string{"id"}},
+		{"POST", "/login", "", nil},
+		{"GET", "/files/{path}", "", []string{"path"}},
+		// net/http serves every method here, so it's left for the user
+		{"", "/health", "Reports whether the service is up.", nil},
+		{"GET", "/api/items", "", nil},
+		{"POST", "/api/items", "", nil},
+	}
+	if len(endpoints) != len(want) {
+		t.Fatalf("expected %d endpoints, got %+v", len(want), endpoints)
+	}
+	for i, w := range want {
+		endpoint := endpoints[i]
+		if endpoint.Method != w.method || endpoint.Path != w.path || endpoint.Description != w.description {
+			t.Errorf("endpoint %d: expected %s %s (%q), got %s %s (%q)", i, w.method, w.path, w.description, endpoint.Method, endpoint.Path, endpoint.Description)
+		}
+		if len(endpoint.Parameters) != len(w.parameters) {
+			t.Errorf("endpoint %d: expected parameters %v, got %+v", i, w.parameters, endpoint.Parameters)
+			continue
+		}
+		for j, name := range w.parameters {
+			if endpoint.Parameters[j].Name != name || !endpoint.Parameters[j].Required {
+				t.Errorf("endpoint %d: expected required parameter %q, got %+v", i, name, endpoint.Parameters[j])
+			}
+		}
+	}
+}
+
+func TestGoRoutes_RoutersAndHandlers(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), "module example.com/app\n\ngo 1.22\n")
+	writeFile(t, filepath.Join(dir, "server.go"), `package main
+
+import "github.com/gin-gonic/gin"
+
+type Server struct {
+	router *gin.Engine
+	cache  *Cache
+}
+`)
+	writeFile(t, filepath.Join(dir, "routes.go"), `package main
+
+import (
+	"net/http"
+
+	"example.com/app/admin"
+	"example.com/app/users"
+	"github.com/gin-gonic/gin"
+)
+
+func (s *Server) routes(uh *UserHandler, deps Deps) {
+	var v string
+	s.cache.Get("/key", &v)
+	http.Post("/upload", "text/plain", nil)
+
+	s.router.GET("/users", users.List)
+	s.router.GET("/admins", admin.List)
+	s.router.GET("/users/:id", uh.Get)
+	s.router.GET("/orders/:id", deps.orders.Get)
+	s.router.GET("/status", s.status)
+}
+
+// status reports the server status.
+func (s *Server) status(c *gin.Context) {}
+
+// Get returns one user.
+func (h *UserHandler) Get(c *gin.Context) {}
+
+// Get returns one order.
+func (h *OrderHandler) Get(c *gin.Context) {}
+`)
+	writeFile(t, filepath.Join(dir, "users", "users.go"), `package users
+
+// List lists the users.
+func List() {}
+`)
+	writeFile(t, filepath.Join(dir, "admin", "admin.go"), `package admin
+
+// List lists the administrators.
+func List() {}
+`)
+
+	endpoints, err := GoRoutes(dir)
+	if err != nil {
+		t.Fatalf("GoRoutes failed: %v", err)
+	}
+
+	want := []struct{ path, description string }{
+		{"/users", "Lists the users."},
+		{"/admins", "Lists the administrators."},
+		{"/users/{id}", "Returns one user."},
+		{"/orders/{id}", ""}, // Get of an unknown type is ambiguous
+		{"/status", "Reports the server status."},
+	}
+	if len(endpoints) != len(want) {
+		t.Fatalf("expected %d endpoints, got %+v", len(want), endpoints)
+	}
+	for i, w := range want {
+		if endpoints[i].Method != "GET" || endpoints[i].Path != w.path || endpoints[i].Description != w.description {
+			t.Errorf("endpoint %d: expected GET %s (%q), got %s %s (%q)", i, w.path, w.description, endpoints[i].Method, endpoints[i].Path, endpoints[i].Description)
+		}
+	}
+}
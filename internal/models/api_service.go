@@ -1,33 +1,78 @@
 package models
 
 type APIService struct {
-	BaseInfo
-	GettingStarted GettingStarted `validate:"required"`
-	Database       *Database      `validate:"omitempty"`      // optional
-	EnvVars        []EnvVar       `validate:"omitempty,dive"` // optional
-	APIDocs        APIDocs        `validate:"required"`
-	Auth           *Auth          `validate:"omitempty"` // optional
-	Testing        *Testing       `validate:"omitempty"` // optional
-	Deployment     *Deployment    `validate:"omitempty"` // optional
-	Monitoring     *Monitoring    `validate:"omitempty"` // optional
+	BaseInfo       `yaml:",inline"`
+	GettingStarted GettingStarted `json:"getting_started" yaml:"getting_started" validate:"required"`
+	Database       *Database      `json:"database,omitempty" yaml:"database,omitempty" validate:"omitempty"`                          // optional
+	EnvVars        []EnvVar       `json:"env_vars,omitempty" yaml:"env_vars,omitempty" validate:"omitempty,dive"`                     // optional
+	APIDocs        APIDocs        `json:"api_docs,omitempty" yaml:"api_docs,omitempty" validate:"required_without=GraphQL,omitempty"` // REST API, optional with GraphQL
+	GraphQL        *GraphQLDocs   `json:"graphql,omitempty" yaml:"graphql,omitempty" validate:"omitempty"`                            // optional
+	Auth           *Auth          `json:"auth,omitempty" yaml:"auth,omitempty" validate:"omitempty"`                                  // optional
+	Testing        *Testing       `json:"testing,omitempty" yaml:"testing,omitempty" validate:"omitempty"`                            // optional
+	Deployment     *Deployment    `json:"deployment,omitempty" yaml:"deployment,omitempty" validate:"omitempty"`                      // optional
+	Monitoring     *Monitoring    `json:"monitoring,omitempty" yaml:"monitoring,omitempty" validate:"omitempty"`                      // optional
+}
+
+// GraphQLDocs documents a GraphQL API, usually imported from its SDL schema
+type GraphQLDocs struct {
+	Endpoint      string             `json:"endpoint" yaml:"endpoint" validate:"required,url"`
+	Playground    string             `json:"playground,omitempty" yaml:"playground,omitempty" validate:"omitempty,url"`
+	Schema        string             `json:"schema,omitempty" yaml:"schema,omitempty" validate:"omitempty,max=200"` // path of the SDL file, e.g. schema.graphql
+	Queries       []GraphQLOperation `json:"queries,omitempty" yaml:"queries,omitempty" validate:"required_without_all=Mutations Subscriptions,omitempty,dive"`
+	Mutations     []GraphQLOperation `json:"mutations,omitempty" yaml:"mutations,omitempty" validate:"omitempty,dive"`
+	Subscriptions []GraphQLOperation `json:"subscriptions,omitempty" yaml:"subscriptions,omitempty" validate:"omitempty,dive"`
+	Types         []GraphQLType      `json:"types,omitempty" yaml:"types,omitempty" validate:"omitempty,dive"` // object, input and enum types operations use
+}
+
+// GraphQLOperation is a field of the Query, Mutation or Subscription type
+type GraphQLOperation struct {
+	Name        string            `json:"name" yaml:"name" validate:"required,graphqlname"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=500"`
+	Arguments   []GraphQLArgument `json:"arguments,omitempty" yaml:"arguments,omitempty" validate:"omitempty,dive"`
+	Type        string            `json:"type" yaml:"type" validate:"required,graphqltype"`                              // return type, e.g. [Pet!]!
+	Deprecated  string            `json:"deprecated,omitempty" yaml:"deprecated,omitempty" validate:"omitempty,max=200"` // deprecation reason
+	Example     string            `json:"example,omitempty" yaml:"example,omitempty" validate:"omitempty,max=2000"`      // example operation, generated when empty
+}
+
+type GraphQLArgument struct {
+	Name        string `json:"name" yaml:"name" validate:"required,graphqlname"`
+	Type        string `json:"type" yaml:"type" validate:"required,graphqltype"`
+	Default     string `json:"default,omitempty" yaml:"default,omitempty" validate:"omitempty,max=100"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=200"`
+}
+
+type GraphQLType struct {
+	Name        string         `json:"name" yaml:"name" validate:"required,graphqlname"`
+	Kind        string         `json:"kind" yaml:"kind" validate:"required,oneof=type input enum interface union scalar"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=500"`
+	Fields      []GraphQLField `json:"fields,omitempty" yaml:"fields,omitempty" validate:"omitempty,dive"`             // type, input and interface fields
+	Values      []string       `json:"values,omitempty" yaml:"values,omitempty" validate:"omitempty,dive,graphqlname"` // enum values or union members
+}
+
+type GraphQLField struct {
+	Name        string `json:"name" yaml:"name" validate:"required,graphqlname"`
+	Type        string `json:"type" yaml:"type" validate:"required,graphqltype"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=200"`
 }
 
 type Auth struct {
-	Method       string   `validate:"required,oneof=jwt oauth api-key basic none"` // "jwt", "oauth", "api-key"
-	TokenFormat  string   `validate:"omitempty,max=200"`
-	ExampleUsage string   `validate:"omitempty,max=500"`
-	Endpoints    []string `validate:"omitempty,dive,startswith=/"` // auth-related endpoints
+	Method       string   `json:"method" yaml:"method" validate:"required,oneof=jwt oauth api-key basic none"` // "jwt", "oauth", "api-key"
+	TokenFormat  string   `json:"token_format,omitempty" yaml:"token_format,omitempty" validate:"omitempty,max=200"`
+	ExampleUsage string   `json:"example_usage,omitempty" yaml:"example_usage,omitempty" validate:"omitempty,max=500"`
+	Endpoints    []string `json:"endpoints,omitempty" yaml:"endpoints,omitempty" validate:"omitempty,dive,startswith=/"`                              // auth-related endpoints
+	KeyName      string   `json:"key_name,omitempty" yaml:"key_name,omitempty" validate:"required_if=Method api-key,omitempty,max=100"`               // api-key: the header, query parameter or cookie
+	KeyIn        string   `json:"key_in,omitempty" yaml:"key_in,omitempty" validate:"required_if=Method api-key,omitempty,oneof=header query cookie"` // api-key: where the key is sent
 }
 
 type Monitoring struct {
-	HealthCheck string        `validate:"omitempty,max=200"`
-	Metrics     []string      `validate:"omitempty,dive,min=1,max=100"`
-	Logging     LoggingConfig `validate:"omitempty"`
-	Alerts      []string      `validate:"omitempty,dive,min=1,max=100"`
+	HealthCheck string        `json:"health_check,omitempty" yaml:"health_check,omitempty" validate:"omitempty,max=200"`
+	Metrics     []string      `json:"metrics,omitempty" yaml:"metrics,omitempty" validate:"omitempty,dive,min=1,max=100"`
+	Logging     LoggingConfig `json:"logging,omitempty" yaml:"logging,omitempty" validate:"omitempty"`
+	Alerts      []string      `json:"alerts,omitempty" yaml:"alerts,omitempty" validate:"omitempty,dive,min=1,max=100"`
 }
 
 type LoggingConfig struct {
-	Level  string `validate:"omitempty,oneof=DEBUG INFO WARN ERROR"`
-	Format string `validate:"omitempty,oneof=json text"`
-	Output string `validate:"omitempty,max=200"` // e.g., file path or stdout
+	Level  string `json:"level,omitempty" yaml:"level,omitempty" validate:"omitempty,oneof=DEBUG INFO WARN ERROR"`
+	Format string `json:"format,omitempty" yaml:"format,omitempty" validate:"omitempty,oneof=json text"`
+	Output string `json:"output,omitempty" yaml:"output,omitempty" validate:"omitempty,max=200"` // e.g., file path or stdout
 }
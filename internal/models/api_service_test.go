@@ -0,0 +1,192 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+func validAPIService() *APIService {
+	return &APIService{
+		BaseInfo: BaseInfo{
+			Title:       "Orders API",
+			Description: "A service that manages customer orders.",
+			License:     "MIT",
+			Author: AuthorInfo{
+				Name:   "John Doe",
+				Email:  "john@example.com",
+				GitHub: "https://github.com/johndoe",
+			},
+		},
+		GettingStarted: GettingStarted{
+			RunCommands: []string{"go run ."},
+		},
+		APIDocs: APIDocs{
+			BaseURL:        "https://api.example.com",
+			Authentication: "Bearer token",
+			Endpoints: []Endpoint{
+				{Method: "GET", Path: "/orders", Description: "List all orders", Response: "[]"},
+			},
+			ErrorHandling: &ErrorHandling{Format: "json"},
+		},
+		Auth: &Auth{Method: "jwt", Endpoints: []string{"/login"}},
+		Monitoring: &Monitoring{
+			HealthCheck: "curl /healthz",
+			Logging:     LoggingConfig{Level: "INFO", Format: "json"},
+		},
+	}
+}
+
+func TestAPIService_Valid(t *testing.T) {
+	err := validation.ValidateStruct(validAPIService())
+	if err != nil {
+		t.Errorf("Valid APIService should pass validation, got: %v", err)
+	}
+}
+
+func TestAPIService_Invalid(t *testing.T) {
+	apiService := validAPIService()
+	apiService.Auth.Method = "magic"                     // not in the oneof list
+	apiService.Auth.Endpoints = []string{"login"}        // must start with /
+	apiService.Monitoring.Logging.Level = "VERBOSE"      // not in the oneof list
+	apiService.APIDocs.Endpoints[0].Path = "orders"      // must start with /
+	apiService.GettingStarted.RunCommands = []string{""} // empty command
+
+	err := validation.ValidateStruct(apiService)
+	if err == nil {
+		t.Errorf("Invalid APIService should fail validation")
+	}
+
+	t.Logf("Validation errors: %v", err)
+}
+
+func TestParameter_Constraints(t *testing.T) {
+	low, high := 1.0, 100.0
+
+	tests := []struct {
+		name    string
+		modify  func(p *Parameter)
+		wantErr bool
+	}{
+		{"plain", func(p *Parameter) {}, false},
+		{"range", func(p *Parameter) { p.Min, p.Max = &low, &high }, false},
+		{"max only", func(p *Parameter) { p.Max = &low }, false},
+		{"max below min", func(p *Parameter) { p.Min, p.Max = &high, &low }, true},
+		{"pattern", func(p *Parameter) { p.Pattern = `^\d+$` }, false},
+		{"bad pattern", func(p *Parameter) { p.Pattern = `([` }, true},
+		{"location", func(p *Parameter) { p.In = "header" }, false},
+		{"bad location", func(p *Parameter) { p.In = "cookie" }, true},
+		{"date-time", func(p *Parameter) { p.Type = "date-time" }, false},
+		{"bad type", func(p *Parameter) { p.Type = "float" }, true},
+		{"empty enum value", func(p *Parameter) { p.Enum = []string{"asc", ""} }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parameter := Parameter{Name: "limit", Type: "int", Description: "Page size", Example: "20"}
+			tt.modify(&parameter)
+
+			err := validation.ValidateStruct(&parameter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIService_GraphQL(t *testing.T) {
+	// a GraphQL-only service doesn't need REST docs
+	apiService := validAPIService()
+	apiService.APIDocs = APIDocs{}
+	apiService.GraphQL = &GraphQLDocs{
+		Endpoint: "https://api.example.com/graphql",
+		Queries: []GraphQLOperation{
+			{Name: "order", Type: "Order", Arguments: []GraphQLArgument{{Name: "id", Type: "ID!"}}},
+		},
+		Types: []GraphQLType{
+			{Name: "Order", Kind: "type", Fields: []GraphQLField{{Name: "id", Type: "ID!"}}},
+		},
+	}
+	if err := validation.ValidateStruct(apiService); err != nil {
+		t.Errorf("GraphQL-only APIService should pass validation, got: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(s *APIService)
+	}{
+		{"no api", func(s *APIService) { s.GraphQL = nil }},
+		{"no operations", func(s *APIService) { s.GraphQL.Queries = nil }},
+		{"bad field name", func(s *APIService) { s.GraphQL.Queries[0].Name = "get-order" }},
+		{"unbalanced type", func(s *APIService) { s.GraphQL.Queries[0].Type = "[Order!" }},
+		{"bad argument type", func(s *APIService) { s.GraphQL.Queries[0].Arguments[0].Type = "ID?" }},
+		{"bad kind", func(s *APIService) { s.GraphQL.Types[0].Kind = "class" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validAPIService()
+			s.APIDocs = APIDocs{}
+			s.GraphQL = &GraphQLDocs{
+				Endpoint: apiService.GraphQL.Endpoint,
+				Queries: []GraphQLOperation{
+					{Name: "order", Type: "Order", Arguments: []GraphQLArgument{{Name: "id", Type: "ID!"}}},
+				},
+				Types: []GraphQLType{
+					{Name: "Order", Kind: "type", Fields: []GraphQLField{{Name: "id", Type: "ID!"}}},
+				},
+			}
+			tt.modify(s)
+			if err := validation.ValidateStruct(s); err == nil {
+				t.Errorf("APIService with %s should fail validation", tt.name)
+			}
+		})
+	}
+}
+
+func TestAPIService_GRPC(t *testing.T) {
+	// a gRPC-only service doesn't need REST endpoints
+	apiService := validAPIService()
+	apiService.APIDocs.Endpoints = nil
+	apiService.APIDocs.GRPC = &GRPCDocs{
+		Services: []GRPCService{{
+			Name:    "orders.v1.OrderService",
+			Methods: []GRPCMethod{{Name: "GetOrder", Request: "orders.v1.GetOrderRequest", Response: "orders.v1.Order"}},
+		}},
+		Messages: []GRPCMessage{
+			{Name: "orders.v1.Order", Kind: "message", Fields: []GRPCField{{Name: "id", Type: "string"}}},
+		},
+	}
+	if err := validation.ValidateStruct(apiService); err != nil {
+		t.Errorf("gRPC-only APIService should pass validation, got: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(s *APIService)
+	}{
+		{"no api", func(s *APIService) { s.APIDocs.GRPC = nil }},
+		{"no methods", func(s *APIService) { s.APIDocs.GRPC.Services[0].Methods = nil }},
+		{"bad service name", func(s *APIService) { s.APIDocs.GRPC.Services[0].Name = "orders/OrderService" }},
+		{"bad label", func(s *APIService) { s.APIDocs.GRPC.Messages[0].Fields[0].Label = "many" }},
+		{"bad kind", func(s *APIService) { s.APIDocs.GRPC.Messages[0].Kind = "union" }},
+		{"bad address", func(s *APIService) { s.APIDocs.GRPC.Address = "localhost 50051" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validAPIService()
+			s.APIDocs.Endpoints = nil
+			s.APIDocs.GRPC = &GRPCDocs{
+				Services: []GRPCService{{
+					Name:    "orders.v1.OrderService",
+					Methods: []GRPCMethod{{Name: "GetOrder", Request: "orders.v1.GetOrderRequest", Response: "orders.v1.Order"}},
+				}},
+				Messages: []GRPCMessage{
+					{Name: "orders.v1.Order", Kind: "message", Fields: []GRPCField{{Name: "id", Type: "string"}}},
+				},
+			}
+			tt.modify(s)
+			if err := validation.ValidateStruct(s); err == nil {
+				t.Errorf("APIService with %s should fail validation", tt.name)
+			}
+		})
+	}
+}
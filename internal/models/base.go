@@ -1,30 +1,40 @@
 package models
 
 type BaseInfo struct {
-	Title       string       `validate:"required,min=1,max=100"`
-	Description string       `validate:"required,min=10,max=500"`
-	Screenshots *Screenshots `validate:"omitempty"` // optional
-	TechStack   *TechStack   `validate:"omitempty"` // optional for CLI tools
-	License     string       `validate:"required,oneof=MIT Apache-2.0 GPL-3.0 BSD-3-Clause ISC Unlicense"`
-	Author      AuthorInfo   `validate:"required"`
+	Title       string       `json:"title" yaml:"title" validate:"required,min=1,max=100"`
+	Description string       `json:"description" yaml:"description" validate:"required,min=10,max=500"`
+	Screenshots *Screenshots `json:"screenshots,omitempty" yaml:"screenshots,omitempty" validate:"omitempty"` // optional
+	TechStack   *TechStack   `json:"tech_stack,omitempty" yaml:"tech_stack,omitempty" validate:"omitempty"`   // optional for CLI tools
+	License     string       `json:"license" yaml:"license" validate:"required,oneof=MIT Apache-2.0 GPL-3.0 BSD-3-Clause ISC Unlicense"`
+	Author      AuthorInfo   `json:"author" yaml:"author" validate:"required"`
 }
 
 type Screenshots struct {
-	Demo        string   `validate:"required,min=1,max=200"`       // path to demo gif/video
-	Images      []string `validate:"omitempty,dive,min=1,max=200"` // optional additional screenshots
-	Description string   `validate:"required,min=5,max=200"`       // caption for demo
+	Demo        string   `json:"demo" yaml:"demo" validate:"required,min=1,max=200"`                               // path to demo gif/video
+	Images      []string `json:"images,omitempty" yaml:"images,omitempty" validate:"omitempty,dive,min=1,max=200"` // optional additional screenshots
+	Description string   `json:"description" yaml:"description" validate:"required,min=5,max=200"`                 // caption for demo
 }
 
 type TechStack struct {
-	Language     string   `validate:"required,min=1,max=50"`
-	Framework    string   `validate:"omitempty,max=50"`             // optional
-	Database     string   `validate:"omitempty,max=50"`             // optional
-	Dependencies []string `validate:"omitempty,dive,min=1,max=100"` // optional
+	Language     string   `json:"language" yaml:"language" validate:"required,min=1,max=50"`
+	Framework    string   `json:"framework,omitempty" yaml:"framework,omitempty" validate:"omitempty,max=50"`                   // optional
+	Database     string   `json:"database,omitempty" yaml:"database,omitempty" validate:"omitempty,max=50"`                     // optional
+	Dependencies []string `json:"dependencies,omitempty" yaml:"dependencies,omitempty" validate:"omitempty,dive,min=1,max=100"` // optional
 }
 
 type AuthorInfo struct {
-	Name    string `validate:"required,min=2,max=50"`
-	Email   string `validate:"required,email"`
-	GitHub  string `validate:"required,url"`  // GitHub profile url
-	Website string `validate:"omitempty,url"` // optional personal website
+	Name    string `json:"name" yaml:"name" validate:"required,min=2,max=50"`
+	Email   string `json:"email" yaml:"email" validate:"required,email"`
+	GitHub  string `json:"github" yaml:"github" validate:"required,url"`                        // GitHub profile url
+	Website string `json:"website,omitempty" yaml:"website,omitempty" validate:"omitempty,url"` // optional personal website
+}
+
+// Project is implemented by every project model through its embedded BaseInfo
+type Project interface {
+	Base() *BaseInfo
+}
+
+// Base gives access to the shared BaseInfo of any project model
+func (b *BaseInfo) Base() *BaseInfo {
+	return b
 }
@@ -1,93 +1,93 @@
 package models
 
 type CLITool struct {
-	BaseInfo
-	QuickStart      QuickStart       `validate:"required"`
-	Installation    Installation     `validate:"required"`
-	Usage           Usage            `validate:"required"`
-	Commands        []Command        `validate:"min=1,dive"`
-	Examples        []Example        `validate:"min=1,dive"`
-	Configuration   *Configuration   `validate:"omitempty"` // optional
-	Troubleshooting *Troubleshooting `validate:"omitempty"` // optional
+	BaseInfo        `yaml:",inline"`
+	QuickStart      QuickStart       `json:"quick_start" yaml:"quick_start" validate:"required"`
+	Installation    Installation     `json:"installation" yaml:"installation" validate:"required"`
+	Usage           Usage            `json:"usage" yaml:"usage" validate:"required"`
+	Commands        []Command        `json:"commands" yaml:"commands" validate:"min=1,dive"`
+	Examples        []Example        `json:"examples" yaml:"examples" validate:"min=1,dive"`
+	Configuration   *Configuration   `json:"configuration,omitempty" yaml:"configuration,omitempty" validate:"omitempty"`     // optional
+	Troubleshooting *Troubleshooting `json:"troubleshooting,omitempty" yaml:"troubleshooting,omitempty" validate:"omitempty"` // optional
 }
 
 type QuickStart struct {
-	Commands    []string `validate:"min=1,dive,min=1"`
-	Description string   `validate:"required,min=10,max=200"`
+	Commands    []string `json:"commands" yaml:"commands" validate:"min=1,dive,min=1"`
+	Description string   `json:"description" yaml:"description" validate:"required,min=10,max=200"`
 }
 
 type Installation struct {
-	PackageManagers []PackageManager `validate:"omitempty,dive"`
-	Binary          *BinaryInstall   `validate:"omitempty"`
-	FromSource      *SourceInstall   `validate:"omitempty"`
+	PackageManagers []PackageManager `json:"package_managers,omitempty" yaml:"package_managers,omitempty" validate:"omitempty,dive"`
+	Binary          *BinaryInstall   `json:"binary,omitempty" yaml:"binary,omitempty" validate:"omitempty"`
+	FromSource      *SourceInstall   `json:"from_source,omitempty" yaml:"from_source,omitempty" validate:"omitempty"`
 }
 
 type PackageManager struct {
-	Name    string `validate:"required,min=1,max=50"`  // "homebrew", "go", "npm"
-	Command string `validate:"required,min=1,max=100"` // e.g., "brew install", "go get", "npm install"
+	Name    string `json:"name" yaml:"name" validate:"required,min=1,max=50"`        // "homebrew", "go", "npm"
+	Command string `json:"command" yaml:"command" validate:"required,min=1,max=100"` // e.g., "brew install", "go get", "npm install"
 }
 
 type BinaryInstall struct {
-	URL          string   `validate:"required,url"`
-	Platforms    []string `validate:"required,min=1,dive,oneof=Windows MacOS Linux"`
-	Instructions string   `validate:"omitempty,max=500"`
+	URL          string   `json:"url" yaml:"url" validate:"required,url"`
+	Platforms    []string `json:"platforms" yaml:"platforms" validate:"required,min=1,dive,oneof=Windows MacOS Linux"`
+	Instructions string   `json:"instructions,omitempty" yaml:"instructions,omitempty" validate:"omitempty,max=500"`
 }
 
 type SourceInstall struct {
-	RepoURL      string   `validate:"required,url"`
-	BuildCmd     string   `validate:"required,min=1,max=200"`
-	Requirements []string `validate:"omitempty,dive,min=1,max=100"`
+	RepoURL      string   `json:"repo_url" yaml:"repo_url" validate:"required,url"`
+	BuildCmd     string   `json:"build_cmd" yaml:"build_cmd" validate:"required,min=1,max=200"`
+	Requirements []string `json:"requirements,omitempty" yaml:"requirements,omitempty" validate:"omitempty,dive,min=1,max=100"`
 }
 
 type Usage struct {
-	BasicUsage  string   `validate:"required,min=10,max=200"`
-	Description string   `validate:"required,min=10,max=500"`
-	CommonFlags []string `validate:"omitempty,dive,min=1,max=30"`
+	BasicUsage  string   `json:"basic_usage" yaml:"basic_usage" validate:"required,min=10,max=200"`
+	Description string   `json:"description" yaml:"description" validate:"required,min=10,max=500"`
+	CommonFlags []string `json:"common_flags,omitempty" yaml:"common_flags,omitempty" validate:"omitempty,dive,min=1,max=30"`
 }
 
 type Command struct {
-	Name        string `validate:"required,min=1,max=50"`
-	Description string `validate:"required,min=5,max=200"`
-	Flags       []Flag `validate:"omitempty,dive"`
+	Name        string `json:"name" yaml:"name" validate:"required,min=1,max=50"`
+	Description string `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Flags       []Flag `json:"flags,omitempty" yaml:"flags,omitempty" validate:"omitempty,dive"`
 }
 
 type Flag struct {
-	Name        string `validate:"required,min=1,max=30"`
-	Short       string `validate:"omitempty,len=1,alpha"`
-	Description string `validate:"required,min=5,max=100"`
-	Default     string `validate:"omitempty,max=50"`
-	Required    bool
+	Name        string `json:"name" yaml:"name" validate:"required,min=1,max=30"`
+	Short       string `json:"short,omitempty" yaml:"short,omitempty" validate:"omitempty,len=1,alpha"`
+	Description string `json:"description" yaml:"description" validate:"required,min=5,max=100"`
+	Default     string `json:"default,omitempty" yaml:"default,omitempty" validate:"omitempty,max=50"`
+	Required    bool   `json:"required,omitempty" yaml:"required,omitempty"`
 }
 
 type Example struct {
-	Title       string   `validate:"required,min=5,max=100"`
-	Description string   `validate:"required,min=10,max=300"`
-	Commands    []string `validate:"required,min=1,dive,min=1"`
-	Output      string   `validate:"omitempty,max=500"`
+	Title       string   `json:"title" yaml:"title" validate:"required,min=5,max=100"`
+	Description string   `json:"description" yaml:"description" validate:"required,min=10,max=300"`
+	Commands    []string `json:"commands" yaml:"commands" validate:"required,min=1,dive,min=1"`
+	Output      string   `json:"output,omitempty" yaml:"output,omitempty" validate:"omitempty,max=500"`
 }
 
 type Configuration struct {
-	ConfigFile string          `validate:"required,min=3,max=50"` // e.g., "config.yaml"
-	EnvVars    []EnvVar        `validate:"omitempty,dive"`
-	Examples   []ConfigExample `validate:"omitempty,dive"`
+	ConfigFile string          `json:"config_file" yaml:"config_file" validate:"required,min=3,max=50"` // e.g., "config.yaml"
+	EnvVars    []EnvVar        `json:"env_vars,omitempty" yaml:"env_vars,omitempty" validate:"omitempty,dive"`
+	Examples   []ConfigExample `json:"examples,omitempty" yaml:"examples,omitempty" validate:"omitempty,dive"`
 }
 
 type ConfigExample struct {
-	Format  string `validate:"required,oneof=yaml json toml"`
-	Content string `validate:"required,min=10,max=1000"`
+	Format  string `json:"format" yaml:"format" validate:"required,oneof=yaml json toml"`
+	Content string `json:"content" yaml:"content" validate:"required,min=10,max=1000"`
 }
 
 type Troubleshooting struct {
-	CommonIssues []Issue `validate:"omitempty,dive"`
-	FAQs         []FAQ   `validate:"omitempty,dive"`
+	CommonIssues []Issue `json:"common_issues,omitempty" yaml:"common_issues,omitempty" validate:"omitempty,dive"`
+	FAQs         []FAQ   `json:"faqs,omitempty" yaml:"faqs,omitempty" validate:"omitempty,dive"`
 }
 
 type Issue struct {
-	Problem  string `validate:"required,min=5,max=200"`
-	Solution string `validate:"required,min=5,max=500"`
+	Problem  string `json:"problem" yaml:"problem" validate:"required,min=5,max=200"`
+	Solution string `json:"solution" yaml:"solution" validate:"required,min=5,max=500"`
 }
 
 type FAQ struct {
-	Question string `validate:"required,min=5,max=200"`
-	Answer   string `validate:"required,min=5,max=500"`
+	Question string `json:"question" yaml:"question" validate:"required,min=5,max=200"`
+	Answer   string `json:"answer" yaml:"answer" validate:"required,min=5,max=500"`
 }
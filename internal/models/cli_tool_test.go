@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+func validCLITool() *CLITool {
+	return &CLITool{
+		BaseInfo: BaseInfo{
+			Title:       "mytool",
+			Description: "A command line tool that does useful things.",
+			License:     "MIT",
+			Author: AuthorInfo{
+				Name:   "John Doe",
+				Email:  "john@example.com",
+				GitHub: "https://github.com/johndoe",
+			},
+		},
+		QuickStart: QuickStart{
+			Commands:    []string{"mytool init"},
+			Description: "Get started in seconds.",
+		},
+		Usage: Usage{
+			BasicUsage:  "mytool [command] [flags]",
+			Description: "Run any command with flags.",
+		},
+		Commands: []Command{
+			{Name: "init", Description: "Initialize a project"},
+		},
+		Examples: []Example{
+			{
+				Title:       "Initialize",
+				Description: "Initialize a project here.",
+				Commands:    []string{"mytool init"},
+			},
+		},
+	}
+}
+
+func TestCLITool_Valid(t *testing.T) {
+	err := validation.ValidateStruct(validCLITool())
+	if err != nil {
+		t.Errorf("Valid CLITool should pass validation, got: %v", err)
+	}
+}
+
+func TestCLITool_Invalid(t *testing.T) {
+	cliTool := validCLITool()
+	cliTool.Commands = nil // at least one command is required
+	cliTool.Installation.Binary = &BinaryInstall{
+		URL:       "not-a-url",
+		Platforms: []string{"Plan9"}, // not in the oneof list
+	}
+
+	err := validation.ValidateStruct(cliTool)
+	if err == nil {
+		t.Errorf("Invalid CLITool should fail validation")
+	}
+
+	t.Logf("Validation errors: %v", err)
+}
@@ -1,58 +1,58 @@
 package models
 
 type FullStackApp struct {
-	BaseInfo
-	Architecture   *Architecture  `validate:"omitempty"` // optional
-	GettingStarted GettingStarted `validate:"required"`
-	EnvVars        []EnvVar       `validate:"omitempty,dive"` // optional
-	AppStructure   *AppStructure  `validate:"omitempty"`      // optional
-	Development    *Development   `validate:"omitempty"`      // optional
-	Testing        *Testing       `validate:"omitempty"`      // optional
-	Deployment     *Deployment    `validate:"omitempty"`      // optional
+	BaseInfo       `yaml:",inline"`
+	Architecture   *Architecture  `json:"architecture,omitempty" yaml:"architecture,omitempty" validate:"omitempty"` // optional
+	GettingStarted GettingStarted `json:"getting_started" yaml:"getting_started" validate:"required"`
+	EnvVars        []EnvVar       `json:"env_vars,omitempty" yaml:"env_vars,omitempty" validate:"omitempty,dive"`      // optional
+	AppStructure   *AppStructure  `json:"app_structure,omitempty" yaml:"app_structure,omitempty" validate:"omitempty"` // optional
+	Development    *Development   `json:"development,omitempty" yaml:"development,omitempty" validate:"omitempty"`     // optional
+	Testing        *Testing       `json:"testing,omitempty" yaml:"testing,omitempty" validate:"omitempty"`             // optional
+	Deployment     *Deployment    `json:"deployment,omitempty" yaml:"deployment,omitempty" validate:"omitempty"`       // optional
 }
 
 type Architecture struct {
-	Pattern    string      `validate:"required,oneof=monolithic microservices serverless mvc mvp mern"`
-	Components []Component `validate:"omitempty,dive"`
-	DataFlow   string      `validate:"omitempty,max=500"`
+	Pattern    string      `json:"pattern" yaml:"pattern" validate:"required,oneof=monolithic microservices serverless mvc mvp mern"`
+	Components []Component `json:"components,omitempty" yaml:"components,omitempty" validate:"omitempty,dive"`
+	DataFlow   string      `json:"data_flow,omitempty" yaml:"data_flow,omitempty" validate:"omitempty,max=500"`
 }
 
 type Component struct {
-	Name        string `validate:"required,min=1,max=50"`
-	Description string `validate:"required,min=5,max=200"`
-	Technology  string `validate:"required,min=1,max=50"`
-	Path        string `validate:"omitempty,max=100"`
+	Name        string `json:"name" yaml:"name" validate:"required,min=1,max=50"`
+	Description string `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Technology  string `json:"technology" yaml:"technology" validate:"required,min=1,max=50"`
+	Path        string `json:"path,omitempty" yaml:"path,omitempty" validate:"omitempty,max=100"`
 }
 
 type AppStructure struct {
-	Frontend *FrontendStructure `validate:"omitempty"`
-	Backend  *BackendStructure  `validate:"omitempty"`
-	Database *DatabaseStructure `validate:"omitempty"`
-	Overview string             `validate:"omitempty,max=500"`
+	Frontend *FrontendStructure `json:"frontend,omitempty" yaml:"frontend,omitempty" validate:"omitempty"`
+	Backend  *BackendStructure  `json:"backend,omitempty" yaml:"backend,omitempty" validate:"omitempty"`
+	Database *DatabaseStructure `json:"database,omitempty" yaml:"database,omitempty" validate:"omitempty"`
+	Overview string             `json:"overview,omitempty" yaml:"overview,omitempty" validate:"omitempty,max=500"`
 }
 
 type FrontendStructure struct {
-	Framework  string   `validate:"required,oneof=React Angular Vue Svelte Next.js Nuxt.js"`
-	Structure  []string `validate:"omitempty,dive,min=1,max=100"`
-	EntryPoint string   `validate:"omitempty,max=100"`
+	Framework  string   `json:"framework" yaml:"framework" validate:"required,oneof=React Angular Vue Svelte Next.js Nuxt.js"`
+	Structure  []string `json:"structure,omitempty" yaml:"structure,omitempty" validate:"omitempty,dive,min=1,max=100"`
+	EntryPoint string   `json:"entry_point,omitempty" yaml:"entry_point,omitempty" validate:"omitempty,max=100"`
 }
 
 type BackendStructure struct {
-	Framework  string   `validate:"required,oneof=Express Django Flask Spring Flask FastAPI Gin Echo Fiber"`
-	Structure  []string `validate:"omitempty,dive,min=1,max=100"`
-	EntryPoint string   `validate:"omitempty,max=100"`
+	Framework  string   `json:"framework" yaml:"framework" validate:"required,oneof=Express Django Flask Spring Flask FastAPI Gin Echo Fiber"`
+	Structure  []string `json:"structure,omitempty" yaml:"structure,omitempty" validate:"omitempty,dive,min=1,max=100"`
+	EntryPoint string   `json:"entry_point,omitempty" yaml:"entry_point,omitempty" validate:"omitempty,max=100"`
 }
 
 type DatabaseStructure struct {
-	Type       string   `validate:"required,oneof=SQL NoSQL Graph InMemory"`
-	Schema     []string `validate:"omitempty,dive,min=1,max=100"`
-	Migrations string   `validate:"omitempty,max=200"`
+	Type       string   `json:"type" yaml:"type" validate:"required,oneof=SQL NoSQL Graph InMemory"`
+	Schema     []string `json:"schema,omitempty" yaml:"schema,omitempty" validate:"omitempty,dive,min=1,max=100"`
+	Migrations string   `json:"migrations,omitempty" yaml:"migrations,omitempty" validate:"omitempty,max=200"`
 }
 
 type Development struct {
-	DevServer   string `validate:"omitempty,max=200"`
-	HotReload   bool
-	DevDatabase string `validate:"omitempty,max=100"`
-	TestData    string `validate:"omitempty,max=200"`
-	Notes       string `validate:"omitempty,max=500"`
+	DevServer   string `json:"dev_server,omitempty" yaml:"dev_server,omitempty" validate:"omitempty,max=200"`
+	HotReload   bool   `json:"hot_reload,omitempty" yaml:"hot_reload,omitempty"`
+	DevDatabase string `json:"dev_database,omitempty" yaml:"dev_database,omitempty" validate:"omitempty,max=100"`
+	TestData    string `json:"test_data,omitempty" yaml:"test_data,omitempty" validate:"omitempty,max=200"`
+	Notes       string `json:"notes,omitempty" yaml:"notes,omitempty" validate:"omitempty,max=500"`
 }
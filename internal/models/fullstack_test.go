@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+func validFullStackApp() *FullStackApp {
+	return &FullStackApp{
+		BaseInfo: BaseInfo{
+			Title:       "Shop",
+			Description: "An online shop with a React frontend.",
+			License:     "MIT",
+			Author: AuthorInfo{
+				Name:   "John Doe",
+				Email:  "john@example.com",
+				GitHub: "https://github.com/johndoe",
+			},
+		},
+		Architecture: &Architecture{
+			Pattern: "mern",
+			Components: []Component{
+				{Name: "web", Description: "Customer facing UI", Technology: "React", Path: "web/"},
+			},
+		},
+		GettingStarted: GettingStarted{
+			RunCommands: []string{"npm start"},
+		},
+		AppStructure: &AppStructure{
+			Frontend: &FrontendStructure{Framework: "React", Structure: []string{"src/"}},
+			Backend:  &BackendStructure{Framework: "Express"},
+			Database: &DatabaseStructure{Type: "NoSQL", Schema: []string{"orders"}},
+		},
+		Development: &Development{DevServer: "npm run dev", HotReload: true},
+	}
+}
+
+func TestFullStackApp_Valid(t *testing.T) {
+	err := validation.ValidateStruct(validFullStackApp())
+	if err != nil {
+		t.Errorf("Valid FullStackApp should pass validation, got: %v", err)
+	}
+}
+
+func TestFullStackApp_Invalid(t *testing.T) {
+	app := validFullStackApp()
+	app.Architecture.Pattern = "spaghetti"         // not in the oneof list
+	app.Architecture.Components[0].Technology = "" // required
+	app.AppStructure.Frontend.Framework = "jQuery" // not in the oneof list
+	app.AppStructure.Database.Type = "Spreadsheet" // not in the oneof list
+	app.GettingStarted.RunCommands = nil           // at least one run command
+
+	err := validation.ValidateStruct(app)
+	if err == nil {
+		t.Errorf("Invalid FullStackApp should fail validation")
+	}
+
+	t.Logf("Validation errors: %v", err)
+}
@@ -1,86 +1,140 @@
 package models
 
 type GettingStarted struct {
-	Prerequisites []string `validate:"omitempty,dive,min=1"`
-	EnvSetup      []string `validate:"omitempty,dive,min=1"`
-	RunCommands   []string `validate:"min=1,dive,min=1"`
-	Notes         *string  `validate:"omitempty,max=500"`
+	Prerequisites []string `json:"prerequisites,omitempty" yaml:"prerequisites,omitempty" validate:"omitempty,dive,min=1"`
+	EnvSetup      []string `json:"env_setup,omitempty" yaml:"env_setup,omitempty" validate:"omitempty,dive,min=1"`
+	RunCommands   []string `json:"run_commands" yaml:"run_commands" validate:"min=1,dive,min=1"`
+	Notes         *string  `json:"notes,omitempty" yaml:"notes,omitempty" validate:"omitempty,max=500"`
 }
 
 type EnvVar struct {
-	Name        string `validate:"required,min=1,max=50"`
-	Description string `validate:"required,min=5,max=200"`
-	Required    bool
-	Default     string `validate:"omitempty,max=100"`
-	Example     string `validate:"required,max=100"`
+	Name        string `json:"name" yaml:"name" validate:"required,min=1,max=50"`
+	Description string `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Required    bool   `json:"required,omitempty" yaml:"required,omitempty"`
+	Default     string `json:"default,omitempty" yaml:"default,omitempty" validate:"omitempty,max=100"`
+	Example     string `json:"example" yaml:"example" validate:"required,max=100"`
 }
 
 type APIDocs struct {
-	BaseURL        string         `validate:"required,url"`
-	Authentication string         `validate:"required"`
-	Endpoints      []Endpoint     `validate:"min=1,dive"`
-	ErrorHandling  *ErrorHandling `validate:"omitempty"`
+	BaseURL        string         `json:"base_url" yaml:"base_url" validate:"required,url"`
+	Authentication string         `json:"authentication" yaml:"authentication" validate:"required"`
+	Endpoints      []Endpoint     `json:"endpoints,omitempty" yaml:"endpoints,omitempty" validate:"required_without=GRPC,omitempty,dive"` // optional with gRPC
+	GRPC           *GRPCDocs      `json:"grpc,omitempty" yaml:"grpc,omitempty" validate:"omitempty"`                                      // optional, served at GRPC.Address or BaseURL
+	ErrorHandling  *ErrorHandling `json:"error_handling,omitempty" yaml:"error_handling,omitempty" validate:"omitempty"`
+}
+
+// GRPCDocs documents gRPC services, usually imported from .proto files
+type GRPCDocs struct {
+	Proto    string        `json:"proto,omitempty" yaml:"proto,omitempty" validate:"omitempty,max=200"` // path of the .proto file or directory
+	Address  string        `json:"address,omitempty" yaml:"address,omitempty" validate:"omitempty,url"` // gRPC server, e.g. grpc://localhost:50051; defaults to the base URL
+	Services []GRPCService `json:"services" yaml:"services" validate:"min=1,dive"`
+	Messages []GRPCMessage `json:"messages,omitempty" yaml:"messages,omitempty" validate:"omitempty,dive"` // messages and enums the methods use
+}
+
+type GRPCService struct {
+	Name        string       `json:"name" yaml:"name" validate:"required,protoname"` // full name, e.g. pets.v1.PetService
+	Description string       `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=500"`
+	Methods     []GRPCMethod `json:"methods" yaml:"methods" validate:"min=1,dive"`
+}
+
+type GRPCMethod struct {
+	Name            string `json:"name" yaml:"name" validate:"required,protoname"`
+	Description     string `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=500"`
+	Request         string `json:"request" yaml:"request" validate:"required,protoname"`   // message type, e.g. pets.v1.GetPetRequest
+	Response        string `json:"response" yaml:"response" validate:"required,protoname"` // message type, e.g. pets.v1.Pet
+	ClientStreaming bool   `json:"client_streaming,omitempty" yaml:"client_streaming,omitempty"`
+	ServerStreaming bool   `json:"server_streaming,omitempty" yaml:"server_streaming,omitempty"`
+	Deprecated      bool   `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+}
+
+type GRPCMessage struct {
+	Name        string      `json:"name" yaml:"name" validate:"required,protoname"` // full name, e.g. pets.v1.Pet
+	Kind        string      `json:"kind" yaml:"kind" validate:"required,oneof=message enum"`
+	Description string      `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=500"`
+	Fields      []GRPCField `json:"fields,omitempty" yaml:"fields,omitempty" validate:"omitempty,dive"`           // message fields
+	Values      []string    `json:"values,omitempty" yaml:"values,omitempty" validate:"omitempty,dive,protoname"` // enum values
+}
+
+type GRPCField struct {
+	Name        string `json:"name" yaml:"name" validate:"required,protoname"`
+	Type        string `json:"type" yaml:"type" validate:"required,max=200"`                                                 // scalar, message or enum name, or map<K, V>
+	Label       string `json:"label,omitempty" yaml:"label,omitempty" validate:"omitempty,oneof=optional repeated required"` // proto label
+	Oneof       string `json:"oneof,omitempty" yaml:"oneof,omitempty" validate:"omitempty,protoname"`                        // oneof the field belongs to
+	Description string `json:"description,omitempty" yaml:"description,omitempty" validate:"omitempty,max=200"`
 }
 
 type Endpoint struct {
-	Method      string      `validate:"required,oneof=GET POST PUT DELETE PATCH OPTIONS HEAD"`
-	Path        string      `validate:"required,startswith=/"`
-	Description string      `validate:"required,min=5,max=200"`
-	Parameters  []Parameter `validate:"omitempty,dive"`
-	Response    string      `validate:"required,min=1"`
+	Method      string      `json:"method" yaml:"method" validate:"required,oneof=GET POST PUT DELETE PATCH OPTIONS HEAD"`
+	Path        string      `json:"path" yaml:"path" validate:"required,startswith=/"`
+	Description string      `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Parameters  []Parameter `json:"parameters,omitempty" yaml:"parameters,omitempty" validate:"omitempty,dive"`
+	Headers     []Header    `json:"headers,omitempty" yaml:"headers,omitempty" validate:"omitempty,dive"`
+	RequestBody string      `json:"request_body,omitempty" yaml:"request_body,omitempty" validate:"omitempty,json,max=2000"` // example JSON body
+	Response    string      `json:"response" yaml:"response" validate:"required,min=1"`
+}
+
+type Header struct {
+	Name  string `json:"name" yaml:"name" validate:"required,min=1,max=100"`
+	Value string `json:"value" yaml:"value" validate:"required,max=200"`
 }
 
 type Parameter struct {
-	Name        string `validate:"required,min=1,max=50"`
-	Type        string `validate:"required,oneof=string int bool"`
-	Required    bool
-	Description string `validate:"required,min=5,max=200"`
-	Example     string `validate:"required,max=100"`
+	Name        string   `json:"name" yaml:"name" validate:"required,min=1,max=50"`
+	In          string   `json:"in,omitempty" yaml:"in,omitempty" validate:"omitempty,oneof=path query header body"` // inferred from the path and method when empty
+	Type        string   `json:"type" yaml:"type" validate:"required,oneof=string int number bool array object file date-time"`
+	Format      string   `json:"format,omitempty" yaml:"format,omitempty" validate:"omitempty,max=50"` // e.g. uuid, email, int64
+	Enum        []string `json:"enum,omitempty" yaml:"enum,omitempty" validate:"omitempty,dive,min=1,max=100"`
+	Min         *float64 `json:"min,omitempty" yaml:"min,omitempty"`                                        // value for numbers, length for strings and arrays
+	Max         *float64 `json:"max,omitempty" yaml:"max,omitempty" validate:"omitempty,gtefieldifset=Min"` // value for numbers, length for strings and arrays
+	Pattern     string   `json:"pattern,omitempty" yaml:"pattern,omitempty" validate:"omitempty,regexp"`    // regular expression values must match
+	Required    bool     `json:"required,omitempty" yaml:"required,omitempty"`
+	Description string   `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Example     string   `json:"example" yaml:"example" validate:"required,max=100"`
 }
 type ErrorHandling struct {
-	Format          string        `validate:"required,oneof=json xml plain"`
-	StatusCodes     []StatusCode  `validate:"omitempty,dive"`
-	ErrorResponse   ErrorResponse `validate:"omitempty"`
-	CommonErrors    []CommonError `validate:"omitempty,dive"`
-	ValidationRules string        `validate:"omitempty,max=500"`
+	Format          string        `json:"format" yaml:"format" validate:"required,oneof=json xml plain"`
+	StatusCodes     []StatusCode  `json:"status_codes,omitempty" yaml:"status_codes,omitempty" validate:"omitempty,dive"`
+	ErrorResponse   ErrorResponse `json:"error_response,omitempty" yaml:"error_response,omitempty" validate:"omitempty"`
+	CommonErrors    []CommonError `json:"common_errors,omitempty" yaml:"common_errors,omitempty" validate:"omitempty,dive"`
+	ValidationRules string        `json:"validation_rules,omitempty" yaml:"validation_rules,omitempty" validate:"omitempty,max=500"`
 }
 
 type StatusCode struct {
-	Code        int    `validate:"required,min=100,max=600"`
-	Description string `validate:"required,min=5,max=200"`
-	Example     string `validate:"omitempty,max=200"`
+	Code        int    `json:"code" yaml:"code" validate:"required,min=100,max=600"`
+	Description string `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Example     string `json:"example,omitempty" yaml:"example,omitempty" validate:"omitempty,max=200"`
 }
 
 type ErrorResponse struct {
-	Structure string `validate:"required"` // JSON structure example
-	Example   string `validate:"required"` // actual example response
+	Structure string `json:"structure" yaml:"structure" validate:"required"` // JSON structure example
+	Example   string `json:"example" yaml:"example" validate:"required"`     // actual example response
 }
 
 type CommonError struct {
-	Code        int    `validate:"required,min=100,max=600"`
-	Message     string `validate:"required,min=5,max=200"`
-	Description string `validate:"required,min=5,max=200"`
-	Solution    string `validate:"required,min=5,max=500"`
+	Code        int    `json:"code" yaml:"code" validate:"required,min=100,max=600"`
+	Message     string `json:"message" yaml:"message" validate:"required,min=5,max=200"`
+	Description string `json:"description" yaml:"description" validate:"required,min=5,max=200"`
+	Solution    string `json:"solution" yaml:"solution" validate:"required,min=5,max=500"`
 }
 
 type Database struct {
-	Type       string `validate:"required,oneof=PostgreSQL MySQL MongoDB Redis SQLite Cassandra MariaDB DynamoDB Firebase"`
-	Schema     string `validate:"required,min=5,max=2000"`
-	Migrations string `validate:"omitempty,min=5,max=500"`
-	SeedData   string `validate:"omitempty,min=5,max=500"`
+	Type       string `json:"type" yaml:"type" validate:"required,oneof=PostgreSQL MySQL MongoDB Redis SQLite Cassandra MariaDB DynamoDB Firebase"`
+	Schema     string `json:"schema" yaml:"schema" validate:"required,min=5,max=2000"`
+	Migrations string `json:"migrations,omitempty" yaml:"migrations,omitempty" validate:"omitempty,min=5,max=500"`
+	SeedData   string `json:"seed_data,omitempty" yaml:"seed_data,omitempty" validate:"omitempty,min=5,max=500"`
 }
 
 type Testing struct {
-	TestCommand   string `validate:"required,min=1,max=200"`
-	CoverageCmd   string `validate:"omitempty,max=200"`
-	TestFramework string `validate:"omitempty,min=1,max=50"`
-	Notes         string `validate:"omitempty,max=500"`
+	TestCommand   string `json:"test_command" yaml:"test_command" validate:"required,min=1,max=200"`
+	CoverageCmd   string `json:"coverage_cmd,omitempty" yaml:"coverage_cmd,omitempty" validate:"omitempty,max=200"`
+	TestFramework string `json:"test_framework,omitempty" yaml:"test_framework,omitempty" validate:"omitempty,min=1,max=50"`
+	Notes         string `json:"notes,omitempty" yaml:"notes,omitempty" validate:"omitempty,max=500"`
 }
 
 type Deployment struct {
-	Platform    string `validate:"required,oneof=Docker Heroku AWS GCP Azure Vercel Netlify"`
-	BuildCmd    string `validate:"omitempty,max=200"`
-	DeployCmd   string `validate:"omitempty,max=200"`
-	HealthCheck string `validate:"omitempty,max=200"`
-	Notes       string `validate:"omitempty,max=500"`
+	Platform    string `json:"platform" yaml:"platform" validate:"required,oneof=Docker Heroku AWS GCP Azure Vercel Netlify"`
+	BuildCmd    string `json:"build_cmd,omitempty" yaml:"build_cmd,omitempty" validate:"omitempty,max=200"`
+	DeployCmd   string `json:"deploy_cmd,omitempty" yaml:"deploy_cmd,omitempty" validate:"omitempty,max=200"`
+	HealthCheck string `json:"health_check,omitempty" yaml:"health_check,omitempty" validate:"omitempty,max=200"`
+	Notes       string `json:"notes,omitempty" yaml:"notes,omitempty" validate:"omitempty,max=500"`
 }
@@ -0,0 +1,462 @@
+package prompts
+
+import (
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+// api service info
+func PromptAPIServiceInfo(defaults *models.APIService) (*models.APIService, error) {
+	if defaults == nil {
+		defaults = &models.APIService{}
+	}
+
+	// prompt for base info
+	baseInfo, err := PromptBaseInfo(&defaults.BaseInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for getting started
+	gettingStarted, err := PromptGettingStartedInfo(&defaults.GettingStarted)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for database
+	var database *models.Database
+	wantDatabase, err := promptYesNo("Do you want to include database details?", defaults.Database != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantDatabase {
+		database, err = PromptDatabaseInfo(defaults.Database)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for environment variables
+	envVars, err := promptItems("environment variable", 0, defaults.EnvVars, PromptEnvVarInfo, summarizeEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for api docs, which a GraphQL-only service can skip
+	var apiDocs models.APIDocs
+	wantREST, err := promptYesNo("Does this service have a REST or gRPC API?", len(defaults.APIDocs.Endpoints) > 0 || defaults.APIDocs.GRPC != nil || defaults.GraphQL == nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantREST {
+		restDocs, err := PromptAPIDocsInfo(&defaults.APIDocs)
+		if err != nil {
+			return nil, err
+		}
+		apiDocs = *restDocs
+	}
+
+	// prompt for graphql, required without a REST or gRPC API
+	var graphQL *models.GraphQLDocs
+	wantGraphQL := !wantREST
+	if wantREST {
+		wantGraphQL, err = promptYesNo("Does this service have a GraphQL API?", defaults.GraphQL != nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if wantGraphQL {
+		graphQL, err = PromptGraphQLInfo(defaults.GraphQL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for auth
+	var auth *models.Auth
+	wantAuth, err := promptYesNo("Do you want to include authentication details?", defaults.Auth != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantAuth {
+		auth, err = PromptAuthInfo(defaults.Auth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for testing
+	var testing *models.Testing
+	wantTesting, err := promptYesNo("Do you want to include testing details?", defaults.Testing != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantTesting {
+		testing, err = PromptTestingInfo(defaults.Testing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for deployment
+	var deployment *models.Deployment
+	wantDeployment, err := promptYesNo("Do you want to include deployment details?", defaults.Deployment != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantDeployment {
+		deployment, err = PromptDeploymentInfo(defaults.Deployment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for monitoring
+	var monitoring *models.Monitoring
+	wantMonitoring, err := promptYesNo("Do you want to include monitoring details?", defaults.Monitoring != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantMonitoring {
+		monitoring, err = PromptMonitoringInfo(defaults.Monitoring)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create APIService
+	apiService := &models.APIService{
+		BaseInfo:       *baseInfo,
+		GettingStarted: *gettingStarted,
+		Database:       database,   // optional
+		EnvVars:        envVars,    // optional
+		APIDocs:        apiDocs,    // optional with GraphQL
+		GraphQL:        graphQL,    // optional
+		Auth:           auth,       // optional
+		Testing:        testing,    // optional
+		Deployment:     deployment, // optional
+		Monitoring:     monitoring, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(apiService); err != nil {
+		return nil, err
+	}
+
+	return apiService, nil
+}
+
+// graphql info
+func PromptGraphQLInfo(defaults *models.GraphQLDocs) (*models.GraphQLDocs, error) {
+	if defaults == nil {
+		defaults = &models.GraphQLDocs{}
+	}
+
+	// prompt endpoint
+	endpoint, err := promptURL("GraphQL endpoint URL", defaults.Endpoint, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt playground
+	playground, err := promptURL("GraphQL playground URL", defaults.Playground, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt schema path
+	schema, err := promptOptionalText("Schema file path", defaults.Schema, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt operations, at least one query unless there are other operations
+	minQueries := 1
+	if len(defaults.Mutations)+len(defaults.Subscriptions) > 0 {
+		minQueries = 0
+	}
+	queries, err := promptItems("query", minQueries, defaults.Queries, PromptGraphQLOperationInfo, summarizeGraphQLOperation)
+	if err != nil {
+		return nil, err
+	}
+	mutations, err := promptItems("mutation", 0, defaults.Mutations, PromptGraphQLOperationInfo, summarizeGraphQLOperation)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := promptItems("subscription", 0, defaults.Subscriptions, PromptGraphQLOperationInfo, summarizeGraphQLOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	// create GraphQLDocs
+	graphQLInfo := &models.GraphQLDocs{
+		Endpoint:      endpoint,
+		Playground:    playground, // optional
+		Schema:        schema,     // optional
+		Queries:       queries,
+		Mutations:     mutations,      // optional
+		Subscriptions: subscriptions,  // optional
+		Types:         defaults.Types, // imported from the schema
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(graphQLInfo); err != nil {
+		return nil, err
+	}
+
+	return graphQLInfo, nil
+}
+
+// graphql operation info
+func PromptGraphQLOperationInfo(defaults *models.GraphQLOperation) (*models.GraphQLOperation, error) {
+	if defaults == nil {
+		defaults = &models.GraphQLOperation{}
+	}
+
+	// prompt name
+	name, err := promptRequiredText("Field name", defaults.Name, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt arguments
+	arguments, err := promptItems("argument", 0, defaults.Arguments, PromptGraphQLArgumentInfo, summarizeGraphQLArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt return type
+	typeValue, err := promptRequiredText("Return type (e.g. [Pet!]!)", defaults.Type, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt description
+	description, err := promptOptionalText("Description", defaults.Description, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt example
+	example, err := promptOptionalText("Example operation (generated when empty)", defaults.Example, 2000)
+	if err != nil {
+		return nil, err
+	}
+
+	// create GraphQLOperation
+	operationInfo := &models.GraphQLOperation{
+		Name:        name,
+		Description: description, // optional
+		Arguments:   arguments,   // optional
+		Type:        typeValue,
+		Deprecated:  defaults.Deprecated, // imported from the schema
+		Example:     example,             // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(operationInfo); err != nil {
+		return nil, err
+	}
+
+	return operationInfo, nil
+}
+
+// graphql argument info
+func PromptGraphQLArgumentInfo(defaults *models.GraphQLArgument) (*models.GraphQLArgument, error) {
+	if defaults == nil {
+		defaults = &models.GraphQLArgument{}
+	}
+
+	// prompt name
+	name, err := promptRequiredText("Argument name", defaults.Name, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt type
+	typeValue, err := promptRequiredText("Argument type (e.g. ID!)", defaults.Type, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt default
+	defaultValue, err := promptOptionalText("Default value", defaults.Default, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt description
+	description, err := promptOptionalText("Description", defaults.Description, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// create GraphQLArgument
+	argumentInfo := &models.GraphQLArgument{
+		Name:        name,
+		Type:        typeValue,
+		Default:     defaultValue, // optional
+		Description: description,  // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(argumentInfo); err != nil {
+		return nil, err
+	}
+
+	return argumentInfo, nil
+}
+
+// auth info
+func PromptAuthInfo(defaults *models.Auth) (*models.Auth, error) {
+	if defaults == nil {
+		defaults = &models.Auth{}
+	}
+
+	// prompt for method
+	method, err := promptFromOptions("Choose the authentication method", []string{"jwt", "oauth", "api-key", "basic", "none"}, defaults.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for where an api key is sent
+	var keyName, keyIn string
+	if method == "api-key" {
+		keyIn, err = promptFromOptions("Where is the API key sent?", []string{"header", "query", "cookie"}, defaults.KeyIn)
+		if err != nil {
+			return nil, err
+		}
+		keyName, err = promptRequiredText("Enter the name of the "+keyIn+" carrying the API key", defaults.KeyName, 1, 100)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for token format
+	tokenFormat, err := promptOptionalText("a token format", defaults.TokenFormat, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for example usage
+	exampleUsage, err := promptOptionalText("an example authenticated request", defaults.ExampleUsage, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for auth endpoints
+	endpoints, err := promptStringList("auth endpoint paths", false, defaults.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// create AuthInfo
+	authInfo := &models.Auth{
+		Method:       method,
+		TokenFormat:  tokenFormat,  // optional
+		ExampleUsage: exampleUsage, // optional
+		Endpoints:    endpoints,    // optional
+		KeyName:      keyName,      // api-key only
+		KeyIn:        keyIn,        // api-key only
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(authInfo); err != nil {
+		return nil, err
+	}
+
+	return authInfo, nil
+}
+
+// monitoring info
+func PromptMonitoringInfo(defaults *models.Monitoring) (*models.Monitoring, error) {
+	if defaults == nil {
+		defaults = &models.Monitoring{}
+	}
+
+	// prompt for health check
+	healthCheck, err := promptOptionalText("a health check command", defaults.HealthCheck, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for metrics
+	metrics, err := promptStringList("metrics", false, defaults.Metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for logging
+	var logging models.LoggingConfig
+	wantLogging, err := promptYesNo("Do you want to include logging configuration?", defaults.Logging != (models.LoggingConfig{}))
+	if err != nil {
+		return nil, err
+	}
+	if wantLogging {
+		loggingConfig, err := PromptLoggingConfigInfo(&defaults.Logging)
+		if err != nil {
+			return nil, err
+		}
+		logging = *loggingConfig
+	}
+
+	// prompt for alerts
+	alerts, err := promptStringList("alerts", false, defaults.Alerts)
+	if err != nil {
+		return nil, err
+	}
+
+	// create MonitoringInfo
+	monitoringInfo := &models.Monitoring{
+		HealthCheck: healthCheck, // optional
+		Metrics:     metrics,     // optional
+		Logging:     logging,     // optional
+		Alerts:      alerts,      // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(monitoringInfo); err != nil {
+		return nil, err
+	}
+
+	return monitoringInfo, nil
+}
+
+// logging config info
+func PromptLoggingConfigInfo(defaults *models.LoggingConfig) (*models.LoggingConfig, error) {
+	if defaults == nil {
+		defaults = &models.LoggingConfig{}
+	}
+
+	// prompt for level
+	level, err := promptFromOptions("Choose the log level", []string{"DEBUG", "INFO", "WARN", "ERROR"}, defaults.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for format
+	format, err := promptFromOptions("Choose the log format", []string{"json", "text"}, defaults.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for output
+	output, err := promptOptionalText("a log output (e.g. stdout or a file path)", defaults.Output, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// create LoggingConfigInfo
+	loggingConfigInfo := &models.LoggingConfig{
+		Level:  level,
+		Format: format,
+		Output: output, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(loggingConfigInfo); err != nil {
+		return nil, err
+	}
+
+	return loggingConfigInfo, nil
+}
@@ -2,6 +2,7 @@ package prompts
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/bycait27/readme-generator/internal/models"
@@ -10,39 +11,43 @@ import (
 	"github.com/manifoldco/promptui"
 )
 
-func PromptBaseInfo() (*models.BaseInfo, error) {
+func PromptBaseInfo(defaults *models.BaseInfo) (*models.BaseInfo, error) {
+	if defaults == nil {
+		defaults = &models.BaseInfo{}
+	}
+
 	// prompt for title
-	title, err := promptRequiredText("Project title", 1, 100)
+	title, err := promptRequiredText("Project title", defaults.Title, 1, 100)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for description
-	description, err := promptRequiredText("Project description", 10, 500)
+	description, err := promptRequiredText("Project description", defaults.Description, 10, 500)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for author info
-	author, err := PromptAuthorInfo()
+	author, err := PromptAuthorInfo(&defaults.Author)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for license
-	license, err := promptFromOptions("Choose project license", []string{"MIT", "Apache-2.0", "GPL-3.0", "BSD-3-Clause", "ISC", "Unlicense"})
+	license, err := promptFromOptions("Choose project license", []string{"MIT", "Apache-2.0", "GPL-3.0", "BSD-3-Clause", "ISC", "Unlicense"}, defaults.License)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for screenshots
 	var screenshots *models.Screenshots
-	wantScreenshots, err := promptYesNo("Do you want to include screenshots/demo?")
+	wantScreenshots, err := promptYesNo("Do you want to include screenshots/demo?", defaults.Screenshots != nil)
 	if err != nil {
 		return nil, err
 	}
 	if wantScreenshots {
-		screenshots, err = PromptScreenshotsInfo()
+		screenshots, err = PromptScreenshotsInfo(defaults.Screenshots)
 		if err != nil {
 			return nil, err
 		}
@@ -50,12 +55,12 @@ func PromptBaseInfo() (*models.BaseInfo, error) {
 
 	// prompt for tech stack
 	var techStack *models.TechStack
-	wantTechStack, err := promptYesNo("Do you want to include tech stack details?")
+	wantTechStack, err := promptYesNo("Do you want to include tech stack details?", defaults.TechStack != nil)
 	if err != nil {
 		return nil, err
 	}
 	if wantTechStack {
-		techStack, err = PromptTechStackInfo()
+		techStack, err = PromptTechStackInfo(defaults.TechStack)
 		if err != nil {
 			return nil, err
 		}
@@ -79,33 +84,48 @@ func PromptBaseInfo() (*models.BaseInfo, error) {
 	return baseInfo, nil
 }
 
-func PromptAuthorInfo() (*models.AuthorInfo, error) {
+func PromptAuthorInfo(defaults *models.AuthorInfo) (*models.AuthorInfo, error) {
+	if defaults == nil {
+		defaults = &models.AuthorInfo{}
+	}
+
+	// offer a complete saved author as is
+	if defaults.Name != "" && validation.ValidateStruct(defaults) == nil {
+		useSaved, err := promptYesNo(fmt.Sprintf("Use author %s <%s> (%s)?", defaults.Name, defaults.Email, defaults.GitHub), true)
+		if err != nil {
+			return nil, err
+		}
+		if useSaved {
+			return defaults, nil
+		}
+	}
+
 	// prompt for name
-	name, err := promptRequiredText("Author name", 2, 50)
+	name, err := promptRequiredText("Author name", defaults.Name, 2, 50)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for email
-	email, err := promptEmail("Author email")
+	email, err := promptEmail("Author email", defaults.Email)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for github
-	github, err := promptURL("Author GitHub", true)
+	github, err := promptURL("Author GitHub", defaults.GitHub, true)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for website
 	var website string
-	wantWebsite, err := promptYesNo("Do you want to add a personal website?")
+	wantWebsite, err := promptYesNo("Do you want to add a personal website?", defaults.Website != "")
 	if err != nil {
 		return nil, err
 	}
 	if wantWebsite {
-		website, err = promptURL("Author website", false)
+		website, err = promptURL("Author website", defaults.Website, false)
 		if err != nil {
 			return nil, err
 		}
@@ -127,20 +147,24 @@ func PromptAuthorInfo() (*models.AuthorInfo, error) {
 	return authorInfo, nil
 }
 
-func PromptScreenshotsInfo() (*models.Screenshots, error) {
+func PromptScreenshotsInfo(defaults *models.Screenshots) (*models.Screenshots, error) {
+	if defaults == nil {
+		defaults = &models.Screenshots{}
+	}
+
 	// prompt for demo
-	demo, err := promptDemo()
+	demo, err := promptDemo(defaults.Demo)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for demo description
-	description, err := promptRequiredText("Demo description", 5, 200)
+	description, err := promptRequiredText("Demo description", defaults.Description, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
-	images, err := promptStringList("any additional screenshot paths", false)
+	images, err := promptStringList("any additional screenshot paths", false, defaults.Images)
 	if err != nil {
 		return nil, err
 	}
@@ -155,31 +179,41 @@ func PromptScreenshotsInfo() (*models.Screenshots, error) {
 	return screenshotsInfo, nil
 }
 
-func PromptTechStackInfo() (*models.TechStack, error) {
+// languageOptions and frameworkOptions include everything the detectors
+// suggest, so a detected value is offered as the default
+var languageOptions = []string{"Go", "Python", "JavaScript", "TypeScript", "Rust", "Java", "C#", "Ruby", "PHP", "C++", "Swift", "Kotlin"}
+
+var frameworkOptions = []string{
+	"Gin", "Echo", "Fiber", "Gorilla Mux", "Chi", // Go frameworks
+	"Express", "Fastify", "Koa", // Node.js
+	"Django", "Flask", "FastAPI", // Python
+	"Axum", "Actix Web", "Rocket", "clap", // Rust
+	"Spring", "Spring Boot", // Java
+	"ASP.NET", "ASP.NET Core", // C#
+	"React", "Next.js", "Angular", "Vue", "Nuxt.js", "Svelte", // Frontend
+	"Ruby on Rails", "Sinatra", // Ruby
+	"Laravel", "Symfony", // PHP
+}
+
+func PromptTechStackInfo(defaults *models.TechStack) (*models.TechStack, error) {
+	if defaults == nil {
+		defaults = &models.TechStack{}
+	}
+
 	// prompt for primary language
-	language, err := promptFromOptions("Primary programming language", []string{"Go", "Python", "JavaScript", "Java", "C#", "Ruby", "PHP", "C++", "TypeScript", "Swift", "Kotlin"})
+	language, err := promptFromOpenOptions("Primary programming language", languageOptions, defaults.Language)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for primary framework
 	var framework string
-	wantFramework, err := promptYesNo("Do you want to specify a framework?")
+	wantFramework, err := promptYesNo("Do you want to specify a framework?", defaults.Framework != "")
 	if err != nil {
 		return nil, err
 	}
 	if wantFramework {
-		framework, err = promptFromOptions("Primary framework",
-			[]string{
-				"Gin", "Echo", "Fiber", "Gorilla Mux", "Chi", // Go frameworks
-				"Express", "Fastify", "Koa", // Node.js
-				"Django", "Flask", "FastAPI", // Python
-				"Spring", "Spring Boot", // Java
-				"ASP.NET", "ASP.NET Core", // C#
-				"React", "Angular", "Vue", "Svelte", // Frontend
-				"Ruby on Rails", "Sinatra", // Ruby
-				"Laravel", "Symfony", // PHP
-			})
+		framework, err = promptFromOpenOptions("Primary framework", frameworkOptions, defaults.Framework)
 		if err != nil {
 			return nil, err
 		}
@@ -187,20 +221,20 @@ func PromptTechStackInfo() (*models.TechStack, error) {
 
 	// prompt for primary database
 	var database string
-	wantDatabase, err := promptYesNo("Do you want to specify a database?")
+	wantDatabase, err := promptYesNo("Do you want to specify a database?", defaults.Database != "")
 	if err != nil {
 		return nil, err
 	}
 	if wantDatabase {
-		database, err = promptFromOptions("Primary database",
-			[]string{"PostgreSQL", "MySQL", "MongoDB", "Redis", "SQLite", "Cassandra", "MariaDB", "OracleDB", "DynamoDB", "Firebase"})
+		database, err = promptFromOpenOptions("Primary database",
+			[]string{"PostgreSQL", "MySQL", "MongoDB", "Redis", "SQLite", "Cassandra", "MariaDB", "OracleDB", "DynamoDB", "Firebase"}, defaults.Database)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	// prompt for key dependencies/libraries
-	dependencies, err := promptStringList("any key dependencies/libraries", false)
+	dependencies, err := promptStringList("any key dependencies/libraries", false, defaults.Dependencies)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +251,10 @@ func PromptTechStackInfo() (*models.TechStack, error) {
 }
 
 // helper function
-func promptDemo() (string, error) {
+func promptDemo(defaultValue string) (string, error) {
 	prompt := promptui.Prompt{
-		Label: "Project demo (file path to GIF/video)",
+		Label:   "Project demo (file path to GIF/video)",
+		Default: defaultValue,
 		Validate: func(input string) error {
 			trimmed := strings.TrimSpace(input)
 			if len(trimmed) < 1 {
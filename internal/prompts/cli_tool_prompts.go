@@ -0,0 +1,668 @@
+package prompts
+
+import (
+	"fmt"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+// cli tool info
+func PromptCLIToolInfo(defaults *models.CLITool) (*models.CLITool, error) {
+	if defaults == nil {
+		defaults = &models.CLITool{}
+	}
+
+	// prompt for base info
+	baseInfo, err := PromptBaseInfo(&defaults.BaseInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for quick start
+	quickStart, err := PromptQuickStartInfo(&defaults.QuickStart)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for installation
+	installation, err := PromptInstallationInfo(&defaults.Installation)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for usage
+	usage, err := PromptUsageInfo(&defaults.Usage)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for commands (at least one)
+	commands, err := promptItems("command", 1, defaults.Commands, PromptCommandInfo, summarizeCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for examples (at least one)
+	examples, err := promptItems("example", 1, defaults.Examples, PromptExampleInfo, summarizeExample)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for configuration
+	var configuration *models.Configuration
+	wantConfiguration, err := promptYesNo("Do you want to include configuration details?", defaults.Configuration != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantConfiguration {
+		configuration, err = PromptConfigurationInfo(defaults.Configuration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for troubleshooting
+	var troubleshooting *models.Troubleshooting
+	wantTroubleshooting, err := promptYesNo("Do you want to include a troubleshooting section?", defaults.Troubleshooting != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantTroubleshooting {
+		troubleshooting, err = PromptTroubleshootingInfo(defaults.Troubleshooting)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create CLITool
+	cliTool := &models.CLITool{
+		BaseInfo:        *baseInfo,
+		QuickStart:      *quickStart,
+		Installation:    *installation,
+		Usage:           *usage,
+		Commands:        commands,
+		Examples:        examples,
+		Configuration:   configuration,   // optional
+		Troubleshooting: troubleshooting, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(cliTool); err != nil {
+		return nil, err
+	}
+
+	return cliTool, nil
+}
+
+// quick start info
+func PromptQuickStartInfo(defaults *models.QuickStart) (*models.QuickStart, error) {
+	if defaults == nil {
+		defaults = &models.QuickStart{}
+	}
+
+	// prompt for description
+	description, err := promptRequiredText("Quick start description", defaults.Description, 10, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for commands
+	commands, err := promptStringList("quick start commands", true, defaults.Commands)
+	if err != nil {
+		return nil, err
+	}
+
+	// create QuickStartInfo
+	quickStartInfo := &models.QuickStart{
+		Commands:    commands,
+		Description: description,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(quickStartInfo); err != nil {
+		return nil, err
+	}
+
+	return quickStartInfo, nil
+}
+
+// installation info
+func PromptInstallationInfo(defaults *models.Installation) (*models.Installation, error) {
+	if defaults == nil {
+		defaults = &models.Installation{}
+	}
+
+	// prompt for package managers
+	packageManagers, err := promptItems("package manager", 0, defaults.PackageManagers, PromptPackageManagerInfo, summarizePackageManager)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for binary download
+	var binary *models.BinaryInstall
+	wantBinary, err := promptYesNo("Are pre-built binaries available for download?", defaults.Binary != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantBinary {
+		binary, err = PromptBinaryInstallInfo(defaults.Binary)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for building from source
+	var fromSource *models.SourceInstall
+	wantFromSource, err := promptYesNo("Do you want to include build from source instructions?", defaults.FromSource != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantFromSource {
+		fromSource, err = PromptSourceInstallInfo(defaults.FromSource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create InstallationInfo
+	installationInfo := &models.Installation{
+		PackageManagers: packageManagers, // optional
+		Binary:          binary,          // optional
+		FromSource:      fromSource,      // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(installationInfo); err != nil {
+		return nil, err
+	}
+
+	return installationInfo, nil
+}
+
+// package manager info
+func PromptPackageManagerInfo(defaults *models.PackageManager) (*models.PackageManager, error) {
+	if defaults == nil {
+		defaults = &models.PackageManager{}
+	}
+
+	// prompt for name
+	name, err := promptRequiredText("Package manager name (e.g. homebrew, go, npm)", defaults.Name, 1, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for command
+	command, err := promptRequiredText("Install command", defaults.Command, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// create PackageManagerInfo
+	packageManagerInfo := &models.PackageManager{
+		Name:    name,
+		Command: command,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(packageManagerInfo); err != nil {
+		return nil, err
+	}
+
+	return packageManagerInfo, nil
+}
+
+// binary install info
+func PromptBinaryInstallInfo(defaults *models.BinaryInstall) (*models.BinaryInstall, error) {
+	if defaults == nil {
+		defaults = &models.BinaryInstall{}
+	}
+
+	// prompt for download url
+	url, err := promptURL("Binary download URL", defaults.URL, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for platforms
+	platforms, err := promptMultiSelect("Supported platform", []string{"Windows", "MacOS", "Linux"}, defaults.Platforms)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for instructions
+	instructions, err := promptOptionalText("installation instructions", defaults.Instructions, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create BinaryInstallInfo
+	binaryInstallInfo := &models.BinaryInstall{
+		URL:          url,
+		Platforms:    platforms,
+		Instructions: instructions, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(binaryInstallInfo); err != nil {
+		return nil, err
+	}
+
+	return binaryInstallInfo, nil
+}
+
+// source install info
+func PromptSourceInstallInfo(defaults *models.SourceInstall) (*models.SourceInstall, error) {
+	if defaults == nil {
+		defaults = &models.SourceInstall{}
+	}
+
+	// prompt for repo url
+	repoURL, err := promptURL("Repository URL", defaults.RepoURL, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for build command
+	buildCmd, err := promptRequiredText("Build command", defaults.BuildCmd, 1, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for requirements
+	requirements, err := promptStringList("build requirements", false, defaults.Requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	// create SourceInstallInfo
+	sourceInstallInfo := &models.SourceInstall{
+		RepoURL:      repoURL,
+		BuildCmd:     buildCmd,
+		Requirements: requirements, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(sourceInstallInfo); err != nil {
+		return nil, err
+	}
+
+	return sourceInstallInfo, nil
+}
+
+// usage info
+func PromptUsageInfo(defaults *models.Usage) (*models.Usage, error) {
+	if defaults == nil {
+		defaults = &models.Usage{}
+	}
+
+	// prompt for basic usage
+	basicUsage, err := promptRequiredText("Basic usage (e.g. mytool [command] [flags])", defaults.BasicUsage, 10, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for description
+	description, err := promptRequiredText("Usage description", defaults.Description, 10, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for common flags
+	commonFlags, err := promptStringList("common flags", false, defaults.CommonFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	// create UsageInfo
+	usageInfo := &models.Usage{
+		BasicUsage:  basicUsage,
+		Description: description,
+		CommonFlags: commonFlags, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(usageInfo); err != nil {
+		return nil, err
+	}
+
+	return usageInfo, nil
+}
+
+// command info
+func PromptCommandInfo(defaults *models.Command) (*models.Command, error) {
+	if defaults == nil {
+		defaults = &models.Command{}
+	}
+
+	// prompt for name
+	name, err := promptRequiredText("Command name", defaults.Name, 1, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for description
+	description, err := promptRequiredText("Command description", defaults.Description, 5, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for flags
+	flags, err := promptItems("flag", 0, defaults.Flags, PromptFlagInfo, summarizeFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	// create CommandInfo
+	commandInfo := &models.Command{
+		Name:        name,
+		Description: description,
+		Flags:       flags, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(commandInfo); err != nil {
+		return nil, err
+	}
+
+	return commandInfo, nil
+}
+
+// flag info
+func PromptFlagInfo(defaults *models.Flag) (*models.Flag, error) {
+	if defaults == nil {
+		defaults = &models.Flag{}
+	}
+
+	// prompt for name
+	name, err := promptRequiredText("Flag name (without dashes)", defaults.Name, 1, 30)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for shorthand
+	short, err := promptOptionalText("a one letter shorthand", defaults.Short, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for description
+	description, err := promptRequiredText("Flag description", defaults.Description, 5, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for default
+	defaultValue, err := promptOptionalText("a default value", defaults.Default, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for required
+	required, err := promptRequiredBoolean("Is this flag required?", defaults.Required)
+	if err != nil {
+		return nil, err
+	}
+
+	// create FlagInfo
+	flagInfo := &models.Flag{
+		Name:        name,
+		Short:       short, // optional
+		Description: description,
+		Default:     defaultValue, // optional
+		Required:    required,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(flagInfo); err != nil {
+		return nil, err
+	}
+
+	return flagInfo, nil
+}
+
+// example info
+func PromptExampleInfo(defaults *models.Example) (*models.Example, error) {
+	if defaults == nil {
+		defaults = &models.Example{}
+	}
+
+	// prompt for title
+	title, err := promptRequiredText("Example title", defaults.Title, 5, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for description
+	description, err := promptRequiredText("Example description", defaults.Description, 10, 300)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for commands
+	commands, err := promptStringList("example commands", true, defaults.Commands)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for output
+	output, err := promptOptionalText("example output", defaults.Output, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create ExampleInfo
+	exampleInfo := &models.Example{
+		Title:       title,
+		Description: description,
+		Commands:    commands,
+		Output:      output, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(exampleInfo); err != nil {
+		return nil, err
+	}
+
+	return exampleInfo, nil
+}
+
+// configuration info
+func PromptConfigurationInfo(defaults *models.Configuration) (*models.Configuration, error) {
+	if defaults == nil {
+		defaults = &models.Configuration{}
+	}
+
+	// prompt for config file
+	configFile, err := promptRequiredText("Config file name (e.g. config.yaml)", defaults.ConfigFile, 3, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for environment variables
+	envVars, err := promptItems("environment variable", 0, defaults.EnvVars, PromptEnvVarInfo, summarizeEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for config examples
+	examples, err := promptItems("configuration example", 0, defaults.Examples, PromptConfigExampleInfo, summarizeConfigExample)
+	if err != nil {
+		return nil, err
+	}
+
+	// create ConfigurationInfo
+	configurationInfo := &models.Configuration{
+		ConfigFile: configFile,
+		EnvVars:    envVars,  // optional
+		Examples:   examples, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(configurationInfo); err != nil {
+		return nil, err
+	}
+
+	return configurationInfo, nil
+}
+
+// config example info
+func PromptConfigExampleInfo(defaults *models.ConfigExample) (*models.ConfigExample, error) {
+	if defaults == nil {
+		defaults = &models.ConfigExample{}
+	}
+
+	// prompt for format
+	format, err := promptFromOptions("Choose the config format", []string{"yaml", "json", "toml"}, defaults.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for content
+	content, err := promptRequiredText("Config example content", defaults.Content, 10, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	// create ConfigExampleInfo
+	configExampleInfo := &models.ConfigExample{
+		Format:  format,
+		Content: content,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(configExampleInfo); err != nil {
+		return nil, err
+	}
+
+	return configExampleInfo, nil
+}
+
+// troubleshooting info
+func PromptTroubleshootingInfo(defaults *models.Troubleshooting) (*models.Troubleshooting, error) {
+	if defaults == nil {
+		defaults = &models.Troubleshooting{}
+	}
+
+	// prompt for common issues
+	issues, err := promptItems("common issue", 0, defaults.CommonIssues, PromptIssueInfo, summarizeIssue)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for faqs
+	faqs, err := promptItems("FAQ", 0, defaults.FAQs, PromptFAQInfo, summarizeFAQ)
+	if err != nil {
+		return nil, err
+	}
+
+	// create TroubleshootingInfo
+	troubleshootingInfo := &models.Troubleshooting{
+		CommonIssues: issues, // optional
+		FAQs:         faqs,   // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(troubleshootingInfo); err != nil {
+		return nil, err
+	}
+
+	return troubleshootingInfo, nil
+}
+
+// issue info
+func PromptIssueInfo(defaults *models.Issue) (*models.Issue, error) {
+	if defaults == nil {
+		defaults = &models.Issue{}
+	}
+
+	// prompt for problem
+	problem, err := promptRequiredText("Problem", defaults.Problem, 5, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for solution
+	solution, err := promptRequiredText("Solution", defaults.Solution, 5, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create IssueInfo
+	issueInfo := &models.Issue{
+		Problem:  problem,
+		Solution: solution,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(issueInfo); err != nil {
+		return nil, err
+	}
+
+	return issueInfo, nil
+}
+
+// faq info
+func PromptFAQInfo(defaults *models.FAQ) (*models.FAQ, error) {
+	if defaults == nil {
+		defaults = &models.FAQ{}
+	}
+
+	// prompt for question
+	question, err := promptRequiredText("Question", defaults.Question, 5, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for answer
+	answer, err := promptRequiredText("Answer", defaults.Answer, 5, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create FAQInfo
+	faqInfo := &models.FAQ{
+		Question: question,
+		Answer:   answer,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(faqInfo); err != nil {
+		return nil, err
+	}
+
+	return faqInfo, nil
+}
+
+// list summaries
+
+func summarizePackageManager(p models.PackageManager) string {
+	return fmt.Sprintf("%s: %s", p.Name, p.Command)
+}
+
+func summarizeCommand(c models.Command) string {
+	return fmt.Sprintf("%s - %s (%d flags)", c.Name, c.Description, len(c.Flags))
+}
+
+func summarizeFlag(f models.Flag) string {
+	if f.Short != "" {
+		return fmt.Sprintf("-%s, --%s - %s", f.Short, f.Name, f.Description)
+	}
+	return fmt.Sprintf("--%s - %s", f.Name, f.Description)
+}
+
+func summarizeExample(e models.Example) string {
+	return fmt.Sprintf("%s (%d commands)", e.Title, len(e.Commands))
+}
+
+func summarizeConfigExample(c models.ConfigExample) string {
+	return fmt.Sprintf("%s (%d characters)", c.Format, len(c.Content))
+}
+
+func summarizeIssue(i models.Issue) string {
+	return i.Problem
+}
+
+func summarizeFAQ(f models.FAQ) string {
+	return f.Question
+}
@@ -0,0 +1,445 @@
+package prompts
+
+import (
+	"fmt"
+
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+// fullstack app info
+func PromptFullStackAppInfo(defaults *models.FullStackApp) (*models.FullStackApp, error) {
+	if defaults == nil {
+		defaults = &models.FullStackApp{}
+	}
+
+	// prompt for base info
+	baseInfo, err := PromptBaseInfo(&defaults.BaseInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for architecture
+	var architecture *models.Architecture
+	wantArchitecture, err := promptYesNo("Do you want to describe the architecture?", defaults.Architecture != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantArchitecture {
+		architecture, err = PromptArchitectureInfo(defaults.Architecture)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for getting started
+	gettingStarted, err := PromptGettingStartedInfo(&defaults.GettingStarted)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for environment variables
+	envVars, err := promptItems("environment variable", 0, defaults.EnvVars, PromptEnvVarInfo, summarizeEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for app structure
+	var appStructure *models.AppStructure
+	wantAppStructure, err := promptYesNo("Do you want to describe the project structure?", defaults.AppStructure != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantAppStructure {
+		appStructure, err = PromptAppStructureInfo(defaults.AppStructure)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for development
+	var development *models.Development
+	wantDevelopment, err := promptYesNo("Do you want to include development setup details?", defaults.Development != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantDevelopment {
+		development, err = PromptDevelopmentInfo(defaults.Development)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for testing
+	var testing *models.Testing
+	wantTesting, err := promptYesNo("Do you want to include testing details?", defaults.Testing != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantTesting {
+		testing, err = PromptTestingInfo(defaults.Testing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for deployment
+	var deployment *models.Deployment
+	wantDeployment, err := promptYesNo("Do you want to include deployment details?", defaults.Deployment != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantDeployment {
+		deployment, err = PromptDeploymentInfo(defaults.Deployment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create FullStackApp
+	fullStackApp := &models.FullStackApp{
+		BaseInfo:       *baseInfo,
+		Architecture:   architecture, // optional
+		GettingStarted: *gettingStarted,
+		EnvVars:        envVars,      // optional
+		AppStructure:   appStructure, // optional
+		Development:    development,  // optional
+		Testing:        testing,      // optional
+		Deployment:     deployment,   // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(fullStackApp); err != nil {
+		return nil, err
+	}
+
+	return fullStackApp, nil
+}
+
+// architecture info
+func PromptArchitectureInfo(defaults *models.Architecture) (*models.Architecture, error) {
+	if defaults == nil {
+		defaults = &models.Architecture{}
+	}
+
+	// prompt for pattern
+	pattern, err := promptFromOptions("Choose the architecture pattern", []string{"monolithic", "microservices", "serverless", "mvc", "mvp", "mern"}, defaults.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for components
+	components, err := promptItems("component", 0, defaults.Components, PromptComponentInfo, summarizeComponent)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for data flow
+	dataFlow, err := promptOptionalText("a data flow description", defaults.DataFlow, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create ArchitectureInfo
+	architectureInfo := &models.Architecture{
+		Pattern:    pattern,
+		Components: components, // optional
+		DataFlow:   dataFlow,   // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(architectureInfo); err != nil {
+		return nil, err
+	}
+
+	return architectureInfo, nil
+}
+
+// component info
+func PromptComponentInfo(defaults *models.Component) (*models.Component, error) {
+	if defaults == nil {
+		defaults = &models.Component{}
+	}
+
+	// prompt for name
+	name, err := promptRequiredText("Component name", defaults.Name, 1, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for description
+	description, err := promptRequiredText("Component description", defaults.Description, 5, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for technology
+	technology, err := promptRequiredText("Component technology", defaults.Technology, 1, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for path
+	path, err := promptOptionalText("a component path", defaults.Path, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// create ComponentInfo
+	componentInfo := &models.Component{
+		Name:        name,
+		Description: description,
+		Technology:  technology,
+		Path:        path, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(componentInfo); err != nil {
+		return nil, err
+	}
+
+	return componentInfo, nil
+}
+
+// app structure info
+func PromptAppStructureInfo(defaults *models.AppStructure) (*models.AppStructure, error) {
+	if defaults == nil {
+		defaults = &models.AppStructure{}
+	}
+
+	// prompt for overview
+	overview, err := promptOptionalText("a project structure overview", defaults.Overview, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for frontend
+	var frontend *models.FrontendStructure
+	wantFrontend, err := promptYesNo("Do you want to describe the frontend structure?", defaults.Frontend != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantFrontend {
+		frontend, err = PromptFrontendStructureInfo(defaults.Frontend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for backend
+	var backend *models.BackendStructure
+	wantBackend, err := promptYesNo("Do you want to describe the backend structure?", defaults.Backend != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantBackend {
+		backend, err = PromptBackendStructureInfo(defaults.Backend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt for database
+	var database *models.DatabaseStructure
+	wantDatabase, err := promptYesNo("Do you want to describe the database structure?", defaults.Database != nil)
+	if err != nil {
+		return nil, err
+	}
+	if wantDatabase {
+		database, err = PromptDatabaseStructureInfo(defaults.Database)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create AppStructureInfo
+	appStructureInfo := &models.AppStructure{
+		Frontend: frontend, // optional
+		Backend:  backend,  // optional
+		Database: database, // optional
+		Overview: overview, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(appStructureInfo); err != nil {
+		return nil, err
+	}
+
+	return appStructureInfo, nil
+}
+
+// frontend structure info
+func PromptFrontendStructureInfo(defaults *models.FrontendStructure) (*models.FrontendStructure, error) {
+	if defaults == nil {
+		defaults = &models.FrontendStructure{}
+	}
+
+	// prompt for framework
+	framework, err := promptFromOptions("Choose the frontend framework", []string{"React", "Angular", "Vue", "Svelte", "Next.js", "Nuxt.js"}, defaults.Framework)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for entry point
+	entryPoint, err := promptOptionalText("a frontend entry point", defaults.EntryPoint, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for structure
+	structure, err := promptStringList("frontend directories/files", false, defaults.Structure)
+	if err != nil {
+		return nil, err
+	}
+
+	// create FrontendStructureInfo
+	frontendStructureInfo := &models.FrontendStructure{
+		Framework:  framework,
+		Structure:  structure,  // optional
+		EntryPoint: entryPoint, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(frontendStructureInfo); err != nil {
+		return nil, err
+	}
+
+	return frontendStructureInfo, nil
+}
+
+// backend structure info
+func PromptBackendStructureInfo(defaults *models.BackendStructure) (*models.BackendStructure, error) {
+	if defaults == nil {
+		defaults = &models.BackendStructure{}
+	}
+
+	// prompt for framework
+	framework, err := promptFromOptions("Choose the backend framework", []string{"Express", "Django", "Flask", "Spring", "FastAPI", "Gin", "Echo", "Fiber"}, defaults.Framework)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for entry point
+	entryPoint, err := promptOptionalText("a backend entry point", defaults.EntryPoint, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for structure
+	structure, err := promptStringList("backend directories/files", false, defaults.Structure)
+	if err != nil {
+		return nil, err
+	}
+
+	// create BackendStructureInfo
+	backendStructureInfo := &models.BackendStructure{
+		Framework:  framework,
+		Structure:  structure,  // optional
+		EntryPoint: entryPoint, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(backendStructureInfo); err != nil {
+		return nil, err
+	}
+
+	return backendStructureInfo, nil
+}
+
+// database structure info
+func PromptDatabaseStructureInfo(defaults *models.DatabaseStructure) (*models.DatabaseStructure, error) {
+	if defaults == nil {
+		defaults = &models.DatabaseStructure{}
+	}
+
+	// prompt for type
+	dbType, err := promptFromOptions("Choose the database type", []string{"SQL", "NoSQL", "Graph", "InMemory"}, defaults.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for schema
+	schema, err := promptStringList("tables/collections", false, defaults.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for migrations
+	migrations, err := promptOptionalText("migration instructions", defaults.Migrations, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// create DatabaseStructureInfo
+	databaseStructureInfo := &models.DatabaseStructure{
+		Type:       dbType,
+		Schema:     schema,     // optional
+		Migrations: migrations, // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(databaseStructureInfo); err != nil {
+		return nil, err
+	}
+
+	return databaseStructureInfo, nil
+}
+
+// development info
+func PromptDevelopmentInfo(defaults *models.Development) (*models.Development, error) {
+	if defaults == nil {
+		defaults = &models.Development{}
+	}
+
+	// prompt for dev server
+	devServer, err := promptOptionalText("a development server command", defaults.DevServer, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for hot reload
+	hotReload, err := promptRequiredBoolean("Is hot reload enabled?", defaults.HotReload)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for dev database
+	devDatabase, err := promptOptionalText("a development database", defaults.DevDatabase, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for test data
+	testData, err := promptOptionalText("test data instructions", defaults.TestData, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt for notes
+	notes, err := promptOptionalText("any additional notes on development", defaults.Notes, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create DevelopmentInfo
+	developmentInfo := &models.Development{
+		DevServer:   devServer, // optional
+		HotReload:   hotReload,
+		DevDatabase: devDatabase, // optional
+		TestData:    testData,    // optional
+		Notes:       notes,       // optional
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(developmentInfo); err != nil {
+		return nil, err
+	}
+
+	return developmentInfo, nil
+}
+
+// list summaries
+
+func summarizeComponent(c models.Component) string {
+	return fmt.Sprintf("%s (%s) - %s", c.Name, c.Technology, c.Description)
+}
@@ -1,32 +1,38 @@
 package prompts
 
 import (
+	"fmt"
+
 	"github.com/bycait27/readme-generator/internal/models"
 	"github.com/bycait27/readme-generator/internal/validation"
 )
 
 // getting started info
-func PromptGettingStartedInfo() (*models.GettingStarted, error) {
+func PromptGettingStartedInfo(defaults *models.GettingStarted) (*models.GettingStarted, error) {
+	if defaults == nil {
+		defaults = &models.GettingStarted{}
+	}
+
 	// prompt for prerequisites
-	prereqs, err := promptStringList("prerequisites", false)
+	prereqs, err := promptStringList("prerequisites", false, defaults.Prerequisites)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for environment setup
-	envSetup, err := promptStringList("environment setup steps", false)
+	envSetup, err := promptStringList("environment setup steps", false, defaults.EnvSetup)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for run commands
-	runCommands, err := promptStringList("Run Commands", true)
+	runCommands, err := promptStringList("Run Commands", true, defaults.RunCommands)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for notes
-	notes, err := promptOptionalStringPointer("any additional notes", 500)
+	notes, err := promptOptionalStringPointer("any additional notes", defaults.Notes, 500)
 	if err != nil {
 		return nil, err
 	}
@@ -48,33 +54,37 @@ func PromptGettingStartedInfo() (*models.GettingStarted, error) {
 }
 
 // environmental variables info
-func PromptEnvVarInfo() (*models.EnvVar, error) {
+func PromptEnvVarInfo(defaults *models.EnvVar) (*models.EnvVar, error) {
+	if defaults == nil {
+		defaults = &models.EnvVar{}
+	}
+
 	// prompt for name
-	name, err := promptRequiredText("Environment variable name", 1, 50)
+	name, err := promptRequiredText("Environment variable name", defaults.Name, 1, 50)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for description
-	description, err := promptRequiredText("Environment variable description", 5, 200)
+	description, err := promptRequiredText("Environment variable description", defaults.Description, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for required
-	required, err := promptRequiredBoolean("Is this environment variable required?")
+	required, err := promptRequiredBoolean("Is this environment variable required?", defaults.Required)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for default
-	defaultValue, err := promptOptionalText("a default value", 100)
+	defaultValue, err := promptOptionalText("a default value", defaults.Default, 100)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt for example
-	example, err := promptRequiredText("Provide an example", 1, 100)
+	example, err := promptRequiredText("Provide an example", defaults.Example, 1, 100)
 	if err != nil {
 		return nil, err
 	}
@@ -97,37 +107,54 @@ func PromptEnvVarInfo() (*models.EnvVar, error) {
 }
 
 // api documentation info
-func PromptAPIDocsInfo() (*models.APIDocs, error) {
+func PromptAPIDocsInfo(defaults *models.APIDocs) (*models.APIDocs, error) {
+	if defaults == nil {
+		defaults = &models.APIDocs{}
+	}
+
 	// prompt base url
-	baseURL, err := promptURL("Base URL", true)
+	baseURL, err := promptURL("Base URL", defaults.BaseURL, true)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt authentication
-	auth, err := promptRequiredText("Authentication type", 1, 50)
+	auth, err := promptRequiredText("Authentication type", defaults.Authentication, 1, 50)
 	if err != nil {
 		return nil, err
 	}
 
-	// prompt endpoints
-	endpoint, err := PromptEndpointInfo()
+	// prompt grpc
+	var grpc *models.GRPCDocs
+	wantGRPC, err := promptYesNo("Does this API serve gRPC?", defaults.GRPC != nil)
 	if err != nil {
 		return nil, err
 	}
-	endpoints := []models.Endpoint{}
-	if endpoint != nil {
-		endpoints = append(endpoints, *endpoint)
+	if wantGRPC {
+		grpc, err = PromptGRPCInfo(defaults.GRPC)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt endpoints (at least one without grpc)
+	minEndpoints := 1
+	if grpc != nil {
+		minEndpoints = 0
+	}
+	endpoints, err := promptItems("endpoint", minEndpoints, defaults.Endpoints, PromptEndpointInfo, summarizeEndpoint)
+	if err != nil {
+		return nil, err
 	}
 
 	// prompt error handling
 	var errHandling *models.ErrorHandling
-	wantErrHandling, err := promptYesNo("Do you want to include error handling information?")
+	wantErrHandling, err := promptYesNo("Do you want to include error handling information?", defaults.ErrorHandling != nil)
 	if err != nil {
 		return nil, err
 	}
 	if wantErrHandling {
-		errHandling, err = PromptErrorHandlingInfo()
+		errHandling, err = PromptErrorHandlingInfo(defaults.ErrorHandling)
 		if err != nil {
 			return nil, err
 		}
@@ -137,7 +164,8 @@ func PromptAPIDocsInfo() (*models.APIDocs, error) {
 	apiDocsInfo := &models.APIDocs{
 		BaseURL:        baseURL,
 		Authentication: auth,
-		Endpoints:      endpoints,
+		Endpoints:      endpoints,   // optional with grpc
+		GRPC:           grpc,        // optional
 		ErrorHandling:  errHandling, // optional
 	}
 
@@ -149,44 +177,188 @@ func PromptAPIDocsInfo() (*models.APIDocs, error) {
 	return apiDocsInfo, nil
 }
 
+// grpc info
+func PromptGRPCInfo(defaults *models.GRPCDocs) (*models.GRPCDocs, error) {
+	if defaults == nil {
+		defaults = &models.GRPCDocs{}
+	}
+
+	// prompt proto path
+	proto, err := promptOptionalText("Proto file path", defaults.Proto, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt server address
+	address, err := promptOptionalText("gRPC server address, if not the base URL (e.g. grpc://localhost:50051)", defaults.Address, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt services (at least one)
+	services, err := promptItems("gRPC service", 1, defaults.Services, PromptGRPCServiceInfo, summarizeGRPCService)
+	if err != nil {
+		return nil, err
+	}
+
+	// create GRPCDocs
+	grpcInfo := &models.GRPCDocs{
+		Proto:    proto,   // optional
+		Address:  address, // optional
+		Services: services,
+		Messages: defaults.Messages, // imported from the proto files
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(grpcInfo); err != nil {
+		return nil, err
+	}
+
+	return grpcInfo, nil
+}
+
+// grpc service info
+func PromptGRPCServiceInfo(defaults *models.GRPCService) (*models.GRPCService, error) {
+	if defaults == nil {
+		defaults = &models.GRPCService{}
+	}
+
+	// prompt name
+	name, err := promptRequiredText("Service name (e.g. pets.v1.PetService)", defaults.Name, 1, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt description
+	description, err := promptOptionalText("Description", defaults.Description, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt methods (at least one)
+	methods, err := promptItems("method", 1, defaults.Methods, PromptGRPCMethodInfo, summarizeGRPCMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// create GRPCService
+	serviceInfo := &models.GRPCService{
+		Name:        name,
+		Description: description, // optional
+		Methods:     methods,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(serviceInfo); err != nil {
+		return nil, err
+	}
+
+	return serviceInfo, nil
+}
+
+// grpc method info
+func PromptGRPCMethodInfo(defaults *models.GRPCMethod) (*models.GRPCMethod, error) {
+	if defaults == nil {
+		defaults = &models.GRPCMethod{}
+	}
+
+	// prompt name
+	name, err := promptRequiredText("Method name", defaults.Name, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt request
+	request, err := promptRequiredText("Request message (e.g. pets.v1.GetPetRequest)", defaults.Request, 1, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt response
+	response, err := promptRequiredText("Response message (e.g. pets.v1.Pet)", defaults.Response, 1, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt streaming
+	clientStreaming, err := promptYesNo("Does the client stream requests?", defaults.ClientStreaming)
+	if err != nil {
+		return nil, err
+	}
+	serverStreaming, err := promptYesNo("Does the server stream responses?", defaults.ServerStreaming)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt description
+	description, err := promptOptionalText("Description", defaults.Description, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	// create GRPCMethod
+	methodInfo := &models.GRPCMethod{
+		Name:            name,
+		Description:     description, // optional
+		Request:         request,
+		Response:        response,
+		ClientStreaming: clientStreaming,
+		ServerStreaming: serverStreaming,
+		Deprecated:      defaults.Deprecated, // imported from the proto files
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(methodInfo); err != nil {
+		return nil, err
+	}
+
+	return methodInfo, nil
+}
+
 // endpoint info
-func PromptEndpointInfo() (*models.Endpoint, error) {
+func PromptEndpointInfo(defaults *models.Endpoint) (*models.Endpoint, error) {
+	if defaults == nil {
+		defaults = &models.Endpoint{}
+	}
+
 	// prompt method
-	method, err := promptFromOptions("Choose endpoint method", []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS", "HEAD"})
+	method, err := promptFromOptions("Choose endpoint method", []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS", "HEAD"}, defaults.Method)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt path
-	path, err := promptRequiredText("Endpoint path", 1, 50)
+	path, err := promptRequiredText("Endpoint path", defaults.Path, 1, 50)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt description
-	description, err := promptRequiredText("Endpoint description", 5, 200)
+	description, err := promptRequiredText("Endpoint description", defaults.Description, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt parameters
-	var parameters []models.Parameter
-	wantParameters, err := promptYesNo("Do you want to include any parameters?")
+	parameters, err := promptItems("parameter", 0, defaults.Parameters, PromptParameterInfo, summarizeParameter)
 	if err != nil {
 		return nil, err
 	}
-	if wantParameters {
-		param, err := PromptParameterInfo()
-		if err != nil {
-			return nil, err
-		}
-		if param != nil {
-			parameters = append(parameters, *param)
-		}
+
+	// prompt headers
+	headers, err := promptItems("header", 0, defaults.Headers, PromptHeaderInfo, summarizeHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt request body
+	requestBody, err := promptOptionalText("Request body example (JSON)", defaults.RequestBody, 2000)
+	if err != nil {
+		return nil, err
 	}
 
 	// prompt response
-	response, err := promptRequiredText("Endpoint response", 1, 200)
+	response, err := promptRequiredText("Endpoint response", defaults.Response, 1, 200)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +368,9 @@ func PromptEndpointInfo() (*models.Endpoint, error) {
 		Method:      method,
 		Path:        path,
 		Description: description,
-		Parameters:  parameters, // optional
+		Parameters:  parameters,  // optional
+		Headers:     headers,     // optional
+		RequestBody: requestBody, // optional
 		Response:    response,
 	}
 
@@ -209,41 +383,96 @@ func PromptEndpointInfo() (*models.Endpoint, error) {
 }
 
 // parameter info
-func PromptParameterInfo() (*models.Parameter, error) {
+func PromptParameterInfo(defaults *models.Parameter) (*models.Parameter, error) {
+	if defaults == nil {
+		defaults = &models.Parameter{}
+	}
+
 	// prompt name
-	name, err := promptRequiredText("Parameter name", 1, 50)
+	name, err := promptRequiredText("Parameter name", defaults.Name, 1, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt location
+	const inferred = "inferred from the path and method"
+	location, err := promptFromOptions("Where is this parameter sent?", []string{inferred, "path", "query", "header", "body"}, defaults.In)
 	if err != nil {
 		return nil, err
 	}
+	if location == inferred {
+		location = ""
+	}
 
 	// prompt type
-	typeValue, err := promptFromOptions("Choose a parameter type", []string{"string", "int", "bool"})
+	typeValue, err := promptFromOptions("Choose a parameter type", []string{"string", "int", "number", "bool", "array", "object", "file", "date-time"}, defaults.Type)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt required
-	required, err := promptRequiredBoolean("Is this parameter required?")
+	required, err := promptRequiredBoolean("Is this parameter required?", defaults.Required)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt description
-	description, err := promptRequiredText("Parameter description", 5, 200)
+	description, err := promptRequiredText("Parameter description", defaults.Description, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt example
-	example, err := promptRequiredText("Parameter example", 1, 100)
+	example, err := promptRequiredText("Parameter example", defaults.Example, 1, 100)
 	if err != nil {
 		return nil, err
 	}
 
+	// prompt format
+	format, err := promptOptionalText("Format (e.g. uuid, email, int64)", defaults.Format, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt enum
+	enum, err := promptStringList("allowed values", false, defaults.Enum)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt min and max, which bound values of numbers and lengths of
+	// strings and arrays
+	var minValue, maxValue *float64
+	if bound := parameterBound(typeValue); bound != "" {
+		minValue, err = promptOptionalFloat("Minimum "+bound, defaults.Min, nil)
+		if err != nil {
+			return nil, err
+		}
+		maxValue, err = promptOptionalFloat("Maximum "+bound, defaults.Max, minValue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prompt pattern
+	var pattern string
+	if typeValue == "string" {
+		pattern, err = promptOptionalPattern("Pattern (regular expression)", defaults.Pattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// create ParameterInfo
 	parameterInfo := &models.Parameter{
 		Name:        name,
+		In:          location, // optional
 		Type:        typeValue,
+		Format:      format,   // optional
+		Enum:        enum,     // optional
+		Min:         minValue, // optional
+		Max:         maxValue, // optional
+		Pattern:     pattern,  // optional
 		Required:    required,
 		Description: description,
 		Example:     example,
@@ -257,61 +486,92 @@ func PromptParameterInfo() (*models.Parameter, error) {
 	return parameterInfo, nil
 }
 
+// parameterBound names what min and max limit for a parameter type, or ""
+// when the type has no bounds
+func parameterBound(parameterType string) string {
+	switch parameterType {
+	case "int", "number":
+		return "value"
+	case "string":
+		return "length"
+	case "array":
+		return "number of items"
+	}
+	return ""
+}
+
+// header info
+func PromptHeaderInfo(defaults *models.Header) (*models.Header, error) {
+	if defaults == nil {
+		defaults = &models.Header{}
+	}
+
+	// prompt name
+	name, err := promptRequiredText("Header name", defaults.Name, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	// prompt value
+	value, err := promptRequiredText("Header example value", defaults.Value, 1, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// create HeaderInfo
+	headerInfo := &models.Header{
+		Name:  name,
+		Value: value,
+	}
+
+	// final validation
+	if err := validation.ValidateStruct(headerInfo); err != nil {
+		return nil, err
+	}
+
+	return headerInfo, nil
+}
+
 // error handling info
-func PromptErrorHandlingInfo() (*models.ErrorHandling, error) {
+func PromptErrorHandlingInfo(defaults *models.ErrorHandling) (*models.ErrorHandling, error) {
+	if defaults == nil {
+		defaults = &models.ErrorHandling{}
+	}
+
 	// prompt format
-	format, err := promptFromOptions("Choose the type of error handling", []string{"json", "xml", "plain"})
+	format, err := promptFromOptions("Choose the type of error handling", []string{"json", "xml", "plain"}, defaults.Format)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt status codes
-	var statusCodes []models.StatusCode
-	wantStatusCodes, err := promptYesNo("Do you want to include the status codes used?")
+	statusCodes, err := promptItems("status code", 0, defaults.StatusCodes, PromptStatusCodeInfo, summarizeStatusCode)
 	if err != nil {
 		return nil, err
 	}
-	if wantStatusCodes {
-		statusCode, err := PromptStatusCodeInfo()
-		if err != nil {
-			return nil, err
-		}
-		if statusCode != nil {
-			statusCodes = append(statusCodes, *statusCode)
-		}
-	}
 
 	// prompt error response
-	var errorResponses *models.ErrorResponse
-	wantErrorResponses, err := promptYesNo("Do you want to include error responses?")
+	var errorResponse models.ErrorResponse
+	wantErrorResponse, err := promptYesNo("Do you want to include error responses?", defaults.ErrorResponse != (models.ErrorResponse{}))
 	if err != nil {
 		return nil, err
 	}
-	if wantErrorResponses {
-		errorResponses, err = PromptErrorResponseInfo()
+	if wantErrorResponse {
+		errorResponseInfo, err := PromptErrorResponseInfo(&defaults.ErrorResponse)
 		if err != nil {
 			return nil, err
 		}
+		errorResponse = *errorResponseInfo
 	}
 
 	// prompt common errors
-	var commonErrors []models.CommonError
-	wantCommonErrors, err := promptYesNo("Do you want to include any common errors?")
+	commonErrors, err := promptItems("common error", 0, defaults.CommonErrors, PromptCommonErrorInfo, summarizeCommonError)
 	if err != nil {
 		return nil, err
 	}
-	if wantCommonErrors {
-		commonError, err := PromptCommonErrorInfo()
-		if err != nil {
-			return nil, err
-		}
-		if commonError != nil {
-			commonErrors = append(commonErrors, *commonError)
-		}
-	}
 
 	// prompt validation rules
-	valRules, err := promptOptionalText("error handling validation rules", 500)
+	valRules, err := promptOptionalText("error handling validation rules", defaults.ValidationRules, 500)
 	if err != nil {
 		return nil, err
 	}
@@ -319,10 +579,10 @@ func PromptErrorHandlingInfo() (*models.ErrorHandling, error) {
 	// create ErrorHandlingInfo
 	errorHandlingInfo := &models.ErrorHandling{
 		Format:          format,
-		StatusCodes:     statusCodes,     // optional
-		ErrorResponse:   *errorResponses, // optional
-		CommonErrors:    commonErrors,    // optional
-		ValidationRules: valRules,        // optional
+		StatusCodes:     statusCodes,   // optional
+		ErrorResponse:   errorResponse, // optional
+		CommonErrors:    commonErrors,  // optional
+		ValidationRules: valRules,      // optional
 	}
 
 	// final validation
@@ -334,21 +594,25 @@ func PromptErrorHandlingInfo() (*models.ErrorHandling, error) {
 }
 
 // status code info
-func PromptStatusCodeInfo() (*models.StatusCode, error) {
+func PromptStatusCodeInfo(defaults *models.StatusCode) (*models.StatusCode, error) {
+	if defaults == nil {
+		defaults = &models.StatusCode{}
+	}
+
 	// prompt code
-	code, err := promptRequiredInt("Status code", 100, 600)
+	code, err := promptRequiredInt("Status code", defaults.Code, 100, 600)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt description
-	description, err := promptRequiredText("Status code description", 5, 200)
+	description, err := promptRequiredText("Status code description", defaults.Description, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt example
-	example, err := promptOptionalText("status code example", 200)
+	example, err := promptOptionalText("status code example", defaults.Example, 200)
 	if err != nil {
 		return nil, err
 	}
@@ -369,15 +633,19 @@ func PromptStatusCodeInfo() (*models.StatusCode, error) {
 }
 
 // error response info
-func PromptErrorResponseInfo() (*models.ErrorResponse, error) {
+func PromptErrorResponseInfo(defaults *models.ErrorResponse) (*models.ErrorResponse, error) {
+	if defaults == nil {
+		defaults = &models.ErrorResponse{}
+	}
+
 	// prompt structure
-	structure, err := promptRequiredText("Error response structure", 1, 50)
+	structure, err := promptRequiredText("Error response structure", defaults.Structure, 1, 50)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt example
-	example, err := promptRequiredText("Example error response", 5, 200)
+	example, err := promptRequiredText("Example error response", defaults.Example, 5, 200)
 	if err != nil {
 		return nil, err
 	}
@@ -397,27 +665,31 @@ func PromptErrorResponseInfo() (*models.ErrorResponse, error) {
 }
 
 // common error info
-func PromptCommonErrorInfo() (*models.CommonError, error) {
+func PromptCommonErrorInfo(defaults *models.CommonError) (*models.CommonError, error) {
+	if defaults == nil {
+		defaults = &models.CommonError{}
+	}
+
 	// prompt code
-	code, err := promptRequiredInt("Common error code", 100, 600)
+	code, err := promptRequiredInt("Common error code", defaults.Code, 100, 600)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt message
-	message, err := promptRequiredText("Common error message", 5, 200)
+	message, err := promptRequiredText("Common error message", defaults.Message, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt description
-	description, err := promptRequiredText("Common error description", 5, 200)
+	description, err := promptRequiredText("Common error description", defaults.Description, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt solution
-	solution, err := promptRequiredText("Common error solution", 5, 500)
+	solution, err := promptRequiredText("Common error solution", defaults.Solution, 5, 500)
 	if err != nil {
 		return nil, err
 	}
@@ -439,27 +711,31 @@ func PromptCommonErrorInfo() (*models.CommonError, error) {
 }
 
 // database info
-func PromptDatabaseInfo() (*models.Database, error) {
+func PromptDatabaseInfo(defaults *models.Database) (*models.Database, error) {
+	if defaults == nil {
+		defaults = &models.Database{}
+	}
+
 	// prompt type
-	dbType, err := promptFromOptions("Choose a database type", []string{"PostgreSQL", "MySQL", "MongoDB", "Redis", "SQLite", "Cassandra", "MariaDB", "DynamoDB", "Firebase"})
+	dbType, err := promptFromOptions("Choose a database type", []string{"PostgreSQL", "MySQL", "MongoDB", "Redis", "SQLite", "Cassandra", "MariaDB", "DynamoDB", "Firebase"}, defaults.Type)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt schema
-	schema, err := promptRequiredText("Database schema", 5, 200)
+	schema, err := promptRequiredText("Database schema", defaults.Schema, 5, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt migrations
-	migrations, err := promptOptionalText("database migrations", 500)
+	migrations, err := promptOptionalText("database migrations", defaults.Migrations, 500)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt seed data
-	seedData, err := promptOptionalText("database seed data", 500)
+	seedData, err := promptOptionalText("database seed data", defaults.SeedData, 500)
 	if err != nil {
 		return nil, err
 	}
@@ -481,27 +757,31 @@ func PromptDatabaseInfo() (*models.Database, error) {
 }
 
 // testing info
-func PromptTestingInfo() (*models.Testing, error) {
+func PromptTestingInfo(defaults *models.Testing) (*models.Testing, error) {
+	if defaults == nil {
+		defaults = &models.Testing{}
+	}
+
 	// prompt test command
-	testCmd, err := promptRequiredText("Run tests command", 1, 200)
+	testCmd, err := promptRequiredText("Run tests command", defaults.TestCommand, 1, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt coverage command
-	coverageCmd, err := promptOptionalText("a coverage command", 200)
+	coverageCmd, err := promptOptionalText("a coverage command", defaults.CoverageCmd, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt test framework
-	testFramework, err := promptOptionalText("a testing framework", 50)
+	testFramework, err := promptOptionalText("a testing framework", defaults.TestFramework, 50)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt notes
-	notes, err := promptOptionalText("any additional notes on testing", 500)
+	notes, err := promptOptionalText("any additional notes on testing", defaults.Notes, 500)
 	if err != nil {
 		return nil, err
 	}
@@ -523,33 +803,37 @@ func PromptTestingInfo() (*models.Testing, error) {
 }
 
 // deployment info
-func PromptDeploymentInfo() (*models.Deployment, error) {
+func PromptDeploymentInfo(defaults *models.Deployment) (*models.Deployment, error) {
+	if defaults == nil {
+		defaults = &models.Deployment{}
+	}
+
 	// prompt platform
-	platform, err := promptFromOptions("Choose a deployment platform", []string{"Docker", "Heroku", "AWS", "GCP", "Azure", "Vercel", "Netlify"})
+	platform, err := promptFromOptions("Choose a deployment platform", []string{"Docker", "Heroku", "AWS", "GCP", "Azure", "Vercel", "Netlify"}, defaults.Platform)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt build command
-	buildCmd, err := promptOptionalText("build command", 200)
+	buildCmd, err := promptOptionalText("build command", defaults.BuildCmd, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt deploy command
-	deployCmd, err := promptOptionalText("deploy command", 200)
+	deployCmd, err := promptOptionalText("deploy command", defaults.DeployCmd, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt health check
-	healthCheck, err := promptOptionalText("health check info", 200)
+	healthCheck, err := promptOptionalText("health check info", defaults.HealthCheck, 200)
 	if err != nil {
 		return nil, err
 	}
 
 	// prompt notes
-	notes, err := promptOptionalText("any additional notes on deployment", 500)
+	notes, err := promptOptionalText("any additional notes on deployment", defaults.Notes, 500)
 	if err != nil {
 		return nil, err
 	}
@@ -570,3 +854,51 @@ func PromptDeploymentInfo() (*models.Deployment, error) {
 
 	return deploymentInfo, nil
 }
+
+// list summaries
+
+func summarizeEnvVar(e models.EnvVar) string {
+	if e.Required {
+		return fmt.Sprintf("%s (required) - %s", e.Name, e.Description)
+	}
+	return fmt.Sprintf("%s - %s", e.Name, e.Description)
+}
+
+func summarizeEndpoint(e models.Endpoint) string {
+	return fmt.Sprintf("%s %s - %s", e.Method, e.Path, e.Description)
+}
+
+func summarizeParameter(p models.Parameter) string {
+	if p.In != "" {
+		return fmt.Sprintf("%s (%s, %s) - %s", p.Name, p.Type, p.In, p.Description)
+	}
+	return fmt.Sprintf("%s (%s) - %s", p.Name, p.Type, p.Description)
+}
+
+func summarizeHeader(h models.Header) string {
+	return fmt.Sprintf("%s: %s", h.Name, h.Value)
+}
+
+func summarizeGRPCService(s models.GRPCService) string {
+	return fmt.Sprintf("%s (%d methods)", s.Name, len(s.Methods))
+}
+
+func summarizeGRPCMethod(m models.GRPCMethod) string {
+	return fmt.Sprintf("%s(%s) returns (%s)", m.Name, m.Request, m.Response)
+}
+
+func summarizeGraphQLOperation(o models.GraphQLOperation) string {
+	return fmt.Sprintf("%s: %s", o.Name, o.Type)
+}
+
+func summarizeGraphQLArgument(a models.GraphQLArgument) string {
+	return fmt.Sprintf("%s: %s", a.Name, a.Type)
+}
+
+func summarizeStatusCode(s models.StatusCode) string {
+	return fmt.Sprintf("%d - %s", s.Code, s.Description)
+}
+
+func summarizeCommonError(c models.CommonError) string {
+	return fmt.Sprintf("%d - %s", c.Code, c.Message)
+}
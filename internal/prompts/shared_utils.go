@@ -3,19 +3,27 @@ package prompts
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/bycait27/readme-generator/internal/validation"
 	"github.com/manifoldco/promptui"
 )
 
 // common utility functions used across all prompts
 
-// promptYesNo asks a yes/no questions
-func promptYesNo(label string) (bool, error) {
+// promptYesNo asks a yes/no questions, starting on the default answer
+func promptYesNo(label string, defaultYes bool) (bool, error) {
+	cursor := 1
+	if defaultYes {
+		cursor = 0
+	}
 	prompt := promptui.Select{
-		Label: label,
-		Items: []string{"Yes", "No"},
+		Label:     label,
+		Items:     []string{"Yes", "No"},
+		CursorPos: cursor,
 	}
 	_, result, err := prompt.Run()
 	if err != nil {
@@ -25,9 +33,10 @@ func promptYesNo(label string) (bool, error) {
 }
 
 // promptRequiredText prompts for required text with validation
-func promptRequiredText(label string, minLength, maxLength int) (string, error) {
+func promptRequiredText(label, defaultValue string, minLength, maxLength int) (string, error) {
 	prompt := promptui.Prompt{
-		Label: label,
+		Label:   label,
+		Default: defaultValue,
 		Validate: func(input string) error {
 			trimmed := strings.TrimSpace(input)
 			if len(trimmed) < minLength {
@@ -43,8 +52,8 @@ func promptRequiredText(label string, minLength, maxLength int) (string, error)
 }
 
 // promptOptionalText prompts for optional text
-func promptOptionalText(label string, maxLength int) (string, error) {
-	wantText, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)))
+func promptOptionalText(label, defaultValue string, maxLength int) (string, error) {
+	wantText, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)), defaultValue != "")
 	if err != nil {
 		return "", err
 	}
@@ -53,13 +62,19 @@ func promptOptionalText(label string, maxLength int) (string, error) {
 		return "", nil
 	}
 
-	return promptRequiredText(label, 1, maxLength)
+	return promptRequiredText(label, defaultValue, 1, maxLength)
 }
 
 // promptRequiredInt prompts for required int with validation
-func promptRequiredInt(label string, minValue, maxValue int) (int, error) {
+func promptRequiredInt(label string, defaultValue, minValue, maxValue int) (int, error) {
+	var defaultText string
+	if defaultValue != 0 {
+		defaultText = strconv.Itoa(defaultValue)
+	}
+
 	prompt := promptui.Prompt{
-		Label: label,
+		Label:   label,
+		Default: defaultText,
 		Validate: func(input string) error {
 			trimmed := strings.TrimSpace(input)
 			if trimmed == "" {
@@ -90,8 +105,8 @@ func promptRequiredInt(label string, minValue, maxValue int) (int, error) {
 }
 
 // promptOptionalInt prompts for optional int
-func promptOptionalInt(label string, minValue, maxValue int) (int, error) {
-	wantInt, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)))
+func promptOptionalInt(label string, defaultValue, minValue, maxValue int) (int, error) {
+	wantInt, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)), defaultValue != 0)
 	if err != nil {
 		return 0, err
 	}
@@ -100,12 +115,81 @@ func promptOptionalInt(label string, minValue, maxValue int) (int, error) {
 		return 0, nil
 	}
 
-	return promptRequiredInt(label, minValue, maxValue)
+	return promptRequiredInt(label, defaultValue, minValue, maxValue)
+}
+
+// promptOptionalFloat prompts for an optional number that returns *float64,
+// refusing values below minValue when it is set
+func promptOptionalFloat(label string, defaultValue, minValue *float64) (*float64, error) {
+	wantNumber, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)), defaultValue != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !wantNumber {
+		return nil, nil
+	}
+
+	var defaultText string
+	if defaultValue != nil {
+		defaultText = strconv.FormatFloat(*defaultValue, 'f', -1, 64)
+	}
+
+	prompt := promptui.Prompt{
+		Label:   label,
+		Default: defaultText,
+		Validate: func(input string) error {
+			val, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
+			if err != nil {
+				return fmt.Errorf("please enter a valid number")
+			}
+			if minValue != nil && val < *minValue {
+				return fmt.Errorf("input must be at least %s", strconv.FormatFloat(*minValue, 'f', -1, 64))
+			}
+			return nil
+		},
+	}
+	result, err := prompt.Run()
+	if err != nil {
+		return nil, err
+	}
+	val, err := strconv.ParseFloat(strings.TrimSpace(result), 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse number: %w", err)
+	}
+	return &val, nil
+}
+
+// promptOptionalPattern prompts for an optional regular expression
+func promptOptionalPattern(label, defaultValue string) (string, error) {
+	wantPattern, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)), defaultValue != "")
+	if err != nil {
+		return "", err
+	}
+
+	if !wantPattern {
+		return "", nil
+	}
+
+	prompt := promptui.Prompt{
+		Label:   label,
+		Default: defaultValue,
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("input is required")
+			}
+			if _, err := regexp.Compile(input); err != nil {
+				return fmt.Errorf("please enter a valid regular expression")
+			}
+			return nil
+		},
+	}
+	return prompt.Run()
 }
 
 // promptOptionalStringPointer prompts for optional text that returns *string
-func promptOptionalStringPointer(label string, maxLength int) (*string, error) {
-	wantText, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)))
+func promptOptionalStringPointer(label string, defaultValue *string, maxLength int) (*string, error) {
+	wantText, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", strings.ToLower(label)), defaultValue != nil)
 	if err != nil {
 		return nil, nil
 	}
@@ -114,7 +198,12 @@ func promptOptionalStringPointer(label string, maxLength int) (*string, error) {
 		return nil, nil
 	}
 
-	text, err := promptRequiredText(label, 1, maxLength)
+	var defaultText string
+	if defaultValue != nil {
+		defaultText = *defaultValue
+	}
+
+	text, err := promptRequiredText(label, defaultText, 1, maxLength)
 	if err != nil {
 		return nil, err
 	}
@@ -122,9 +211,24 @@ func promptOptionalStringPointer(label string, maxLength int) (*string, error) {
 	return &text, nil
 }
 
-// promptStringList collects a list of strings
-func promptStringList(itemName string, required bool) ([]string, error) {
-	wantItems, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", itemName))
+// promptStringList collects a list of strings, offering to keep any defaults
+func promptStringList(itemName string, required bool, defaults []string) ([]string, error) {
+	if len(defaults) > 0 {
+		fmt.Printf("📋 Current %s:\n", itemName)
+		for _, item := range defaults {
+			fmt.Printf("  - %s\n", item)
+		}
+
+		keep, err := promptYesNo(fmt.Sprintf("Keep these %s?", itemName), true)
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			return defaults, nil
+		}
+	}
+
+	wantItems, err := promptYesNo(fmt.Sprintf("Do you want to add %s?", itemName), required)
 	if err != nil {
 		return nil, err
 	}
@@ -166,25 +270,199 @@ func promptStringList(itemName string, required bool) ([]string, error) {
 	return items, nil
 }
 
-// promptFromOptions prompts user to select from predefined options
-func promptFromOptions(label string, options []string) (string, error) {
+// promptItems collects a list of structs, showing a running summary and letting
+// the user add, edit or delete items until they are done. promptItem is given
+// the item being edited as its defaults, or nil for a new item. Defaults that
+// don't validate, such as imported items, are edited before the menu is shown.
+func promptItems[T any](itemName string, minItems int, defaults []T, promptItem func(*T) (*T, error), summarize func(T) string) ([]T, error) {
+	var (
+		items   = append([]T(nil), defaults...)
+		add     = fmt.Sprintf("Add %s", itemName)
+		another = fmt.Sprintf("Add another %s", itemName)
+		edit    = fmt.Sprintf("Edit an existing %s", itemName)
+		remove  = fmt.Sprintf("Delete an existing %s", itemName)
+		done    = "Done"
+	)
+
+	// imported items may still be missing required details
+	for i := range items {
+		if err := validation.ValidateStruct(&items[i]); err != nil {
+			fmt.Printf("✏️  %s %d needs more details (%v)\n", itemName, i+1, err)
+			item, err := promptItem(&items[i])
+			if err != nil {
+				return nil, err
+			}
+			items[i] = *item
+		}
+	}
+
+	for {
+		// required items are collected before offering the menu
+		if len(items) < minItems {
+			fmt.Printf("➕ %s %d of at least %d\n", itemName, len(items)+1, minItems)
+			item, err := promptItem(nil)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, *item)
+			continue
+		}
+
+		// running summary
+		if len(items) > 0 {
+			fmt.Printf("📋 %s list (%d):\n", itemName, len(items))
+			for i, item := range items {
+				fmt.Printf("  %d. %s\n", i+1, summarize(item))
+			}
+		}
+
+		actions := []string{add, done}
+		if len(items) > 0 {
+			actions = []string{another, edit, remove, done}
+		}
+		// saved lists usually just need confirming
+		defaultAction := add
+		if len(defaults) > 0 {
+			defaultAction = done
+		}
+		action, err := promptFromOptions(fmt.Sprintf("Next step for %s list", itemName), actions, defaultAction)
+		if err != nil {
+			return nil, err
+		}
+
+		switch action {
+		case add, another:
+			item, err := promptItem(nil)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, *item)
+		case edit:
+			i, err := promptItemIndex(fmt.Sprintf("Which %s do you want to edit?", itemName), items, summarize)
+			if err != nil {
+				return nil, err
+			}
+			item, err := promptItem(&items[i])
+			if err != nil {
+				return nil, err
+			}
+			items[i] = *item
+		case remove:
+			i, err := promptItemIndex(fmt.Sprintf("Which %s do you want to delete?", itemName), items, summarize)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items[:i], items[i+1:]...)
+		case done:
+			return items, nil
+		}
+	}
+}
+
+// promptItemIndex asks the user to pick one of the collected items
+func promptItemIndex[T any](label string, items []T, summarize func(T) string) (int, error) {
+	summaries := make([]string, len(items))
+	for i, item := range items {
+		summaries[i] = fmt.Sprintf("%d. %s", i+1, summarize(item))
+	}
+
 	prompt := promptui.Select{
 		Label: label,
-		Items: options,
+		Items: summaries,
+	}
+	i, _, err := prompt.Run()
+	return i, err
+}
+
+// promptFromOptions prompts user to select from predefined options,
+// starting on the default option when it's one of them
+func promptFromOptions(label string, options []string, defaultOption string) (string, error) {
+	return promptSelect(label, options, defaultOption, false)
+}
+
+// promptFromOpenOptions is promptFromOptions for free-form fields, where
+// the options are only suggestions: a default that isn't one of them is
+// offered too
+func promptFromOpenOptions(label string, options []string, defaultOption string) (string, error) {
+	return promptSelect(label, options, defaultOption, true)
+}
+
+// promptSelect prompts a selection starting on the default option
+func promptSelect(label string, options []string, defaultOption string, addUnlisted bool) (string, error) {
+	items, cursor := withDefaultOption(options, defaultOption, addUnlisted)
+	prompt := promptui.Select{
+		Label:     label,
+		Items:     items,
+		CursorPos: cursor,
 	}
 	_, result, err := prompt.Run()
 	return result, err
 }
 
+// withDefaultOption returns the options and the index of the default. With
+// addUnlisted, a default that isn't one of the options, such as a detected
+// or saved value, is added first so the user can still confirm it;
+// otherwise the cursor starts on the first option.
+func withDefaultOption(options []string, defaultOption string, addUnlisted bool) ([]string, int) {
+	if i := slices.Index(options, defaultOption); i >= 0 || defaultOption == "" || !addUnlisted {
+		return options, max(i, 0)
+	}
+	return append([]string{defaultOption}, options...), 0
+}
+
+// promptMultiSelect lets the user pick one or more of the predefined options,
+// starting with the defaults already selected
+func promptMultiSelect(label string, options, defaults []string) ([]string, error) {
+	const done = "Done"
+	var selected, remaining []string
+	for _, option := range options {
+		if slices.Contains(defaults, option) {
+			selected = append(selected, option)
+		} else {
+			remaining = append(remaining, option)
+		}
+	}
+
+	for len(remaining) > 0 {
+		items := remaining
+		if len(selected) > 0 {
+			items = append([]string{done}, remaining...)
+		}
+
+		prompt := promptui.Select{
+			Label: fmt.Sprintf("%s (selected: %s)", label, strings.Join(selected, ", ")),
+			Items: items,
+		}
+		_, result, err := prompt.Run()
+		if err != nil {
+			return nil, err
+		}
+		if result == done {
+			break
+		}
+
+		selected = append(selected, result)
+		for i, option := range remaining {
+			if option == result {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return selected, nil
+}
+
 // promptRequiredBoolean prompts the user to provide a boolean response
-func promptRequiredBoolean(label string) (bool, error) {
-	return promptYesNo(label)
+func promptRequiredBoolean(label string, defaultValue bool) (bool, error) {
+	return promptYesNo(label, defaultValue)
 }
 
 // promptURL prompts for a URL with validation
-func promptURL(label string, required bool) (string, error) {
+func promptURL(label, defaultValue string, required bool) (string, error) {
 	prompt := promptui.Prompt{
-		Label: label,
+		Label:   label,
+		Default: defaultValue,
 		Validate: func(input string) error {
 			trimmed := strings.TrimSpace(input)
 			if !required && trimmed == "" {
@@ -201,9 +479,10 @@ func promptURL(label string, required bool) (string, error) {
 }
 
 // promptEmail prompts for email with basic validation
-func promptEmail(label string) (string, error) {
+func promptEmail(label, defaultValue string) (string, error) {
 	prompt := promptui.Prompt{
-		Label: label,
+		Label:   label,
+		Default: defaultValue,
 		Validate: func(input string) error {
 			trimmed := strings.TrimSpace(input)
 			if trimmed == "" {
@@ -0,0 +1,41 @@
+package prompts
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithDefaultOption(t *testing.T) {
+	// a Rust crate's detected tech stack is offered as the default
+	for _, tt := range []struct {
+		options []string
+		value   string
+	}{
+		{languageOptions, "Rust"},
+		{frameworkOptions, "Axum"},
+		{frameworkOptions, "Actix Web"},
+		{frameworkOptions, "Next.js"},
+	} {
+		items, cursor := withDefaultOption(tt.options, tt.value, true)
+		if items[cursor] != tt.value || len(items) != len(tt.options) {
+			t.Errorf("expected %s among the options with the cursor on it, got %v at %d", tt.value, items, cursor)
+		}
+	}
+
+	// an unlisted default is added rather than replaced by the first option
+	items, cursor := withDefaultOption([]string{"Go", "Python"}, "Zig", true)
+	if items[cursor] != "Zig" || !slices.Equal(items, []string{"Zig", "Go", "Python"}) {
+		t.Errorf("expected Zig added as the default, got %v at %d", items, cursor)
+	}
+
+	// unless the field only accepts the options, such as a oneof license
+	items, cursor = withDefaultOption([]string{"MIT", "Apache-2.0"}, "WTFPL", false)
+	if cursor != 0 || !slices.Equal(items, []string{"MIT", "Apache-2.0"}) {
+		t.Errorf("expected the invalid default dropped, got %v at %d", items, cursor)
+	}
+
+	items, cursor = withDefaultOption([]string{"Go", "Python"}, "", true)
+	if cursor != 0 || len(items) != 2 {
+		t.Errorf("no default should leave the options alone, got %v at %d", items, cursor)
+	}
+}
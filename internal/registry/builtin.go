@@ -0,0 +1,49 @@
+package registry
+
+import (
+	"github.com/bycait27/readme-generator/internal/models"
+	"github.com/bycait27/readme-generator/internal/prompts"
+)
+
+// built-in project types, one per template in templates/
+func init() {
+	Register(ProjectType{
+		Name:        "basic",
+		Description: "Any project: description, tech stack, license and contact",
+		NewModel:    func() interface{} { return &models.BaseInfo{} },
+		Prompt: func(defaults interface{}) (interface{}, error) {
+			d, _ := defaults.(*models.BaseInfo)
+			return prompts.PromptBaseInfo(d)
+		},
+	})
+
+	Register(ProjectType{
+		Name:        "cli-tool",
+		Description: "Command line tools: installation, usage, commands and flags",
+		NewModel:    func() interface{} { return &models.CLITool{} },
+		Prompt: func(defaults interface{}) (interface{}, error) {
+			d, _ := defaults.(*models.CLITool)
+			return prompts.PromptCLIToolInfo(d)
+		},
+	})
+
+	Register(ProjectType{
+		Name:        "api-service",
+		Description: "Backend services: endpoints, auth, database and monitoring",
+		NewModel:    func() interface{} { return &models.APIService{} },
+		Prompt: func(defaults interface{}) (interface{}, error) {
+			d, _ := defaults.(*models.APIService)
+			return prompts.PromptAPIServiceInfo(d)
+		},
+	})
+
+	Register(ProjectType{
+		Name:        "fullstack",
+		Description: "Full stack apps: architecture, app structure and development",
+		NewModel:    func() interface{} { return &models.FullStackApp{} },
+		Prompt: func(defaults interface{}) (interface{}, error) {
+			d, _ := defaults.(*models.FullStackApp)
+			return prompts.PromptFullStackAppInfo(d)
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package registry
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/generator"
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+// ProjectType ties a template to the model it renders and the prompts that fill it
+type ProjectType struct {
+	Name        string                                          // template name, e.g. "cli-tool"
+	Description string                                          // short summary shown by the list command
+	NewModel    func() interface{}                              // returns a pointer to an empty model
+	Prompt      func(defaults interface{}) (interface{}, error) // interactive questionnaire, pre-filled from defaults
+}
+
+var (
+	projectTypes = map[string]ProjectType{}
+	names        []string // registration order
+)
+
+// Register adds a project type to the registry
+func Register(p ProjectType) {
+	if p.Name == "" || p.NewModel == nil || p.Prompt == nil {
+		panic("registry: project type needs a name, model and prompt")
+	}
+	if _, exists := projectTypes[p.Name]; exists {
+		panic(fmt.Sprintf("registry: project type %s registered twice", p.Name))
+	}
+
+	projectTypes[p.Name] = p
+	names = append(names, p.Name)
+}
+
+// Lookup returns the project type registered for a template name. A
+// custom template that extends a registered one gets that project type,
+// rendered with the custom template.
+func Lookup(name string) (ProjectType, error) {
+	if p, ok := projectTypes[name]; ok {
+		return p, nil
+	}
+	if err := generator.CheckTemplateName(name); err != nil {
+		return ProjectType{}, err
+	}
+	unknown := fmt.Errorf("unknown template %s (available: %s)", name, strings.Join(names, ", "))
+	if _, err := generator.TemplateSource(name); err != nil {
+		return ProjectType{}, unknown
+	}
+
+	base, err := generator.BaseTemplate(name)
+	if err != nil {
+		return ProjectType{}, err
+	}
+	p, ok := projectTypes[base]
+	if !ok {
+		return ProjectType{}, unknown
+	}
+	p.Name = name
+	p.Description = "Extends " + base
+	return p, nil
+}
+
+// Names returns the registered template names in registration order
+func Names() []string {
+	return append([]string(nil), names...)
+}
+
+// All returns the registered project types in registration order
+func All() []ProjectType {
+	all := make([]ProjectType, 0, len(names))
+	for _, name := range names {
+		all = append(all, projectTypes[name])
+	}
+	return all
+}
+
+// Check makes sure data is the model this project type's template expects
+func (p ProjectType) Check(data interface{}) error {
+	want := reflect.TypeOf(p.NewModel())
+	got := reflect.TypeOf(data)
+	if got != want {
+		return fmt.Errorf("template %s expects %v, got %v", p.Name, want, got)
+	}
+	if reflect.ValueOf(data).IsNil() {
+		return fmt.Errorf("template %s was given a nil %v", p.Name, want)
+	}
+	return nil
+}
+
+// Validate checks the kind of data and then its validation tags
+func (p ProjectType) Validate(data interface{}) error {
+	if err := p.Check(data); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(data)
+}
+
+// Render validates data and writes the README to filePath
+func (p ProjectType) Render(data interface{}, filePath string) error {
+	if err := p.Validate(data); err != nil {
+		return err
+	}
+	return generator.GenerateREADMEToFile(p.Name, data, filePath)
+}
@@ -0,0 +1,118 @@
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/generator"
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func TestBuiltinsHaveTemplates(t *testing.T) {
+	for _, projectType := range All() {
+		if err := generator.ValidateTemplate(projectType.Name); err != nil {
+			t.Errorf("project type %s has no template: %v", projectType.Name, err)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	projectType, err := Lookup("cli-tool")
+	if err != nil {
+		t.Fatalf("cli-tool should be registered: %v", err)
+	}
+	if _, ok := projectType.NewModel().(*models.CLITool); !ok {
+		t.Errorf("cli-tool should use *models.CLITool, got %T", projectType.NewModel())
+	}
+
+	if _, err := Lookup("nonexistent"); err == nil {
+		t.Error("nonexistent template should return error")
+	}
+	if _, err := Lookup("../cli-tool"); err == nil || !strings.Contains(err.Error(), "invalid template name") {
+		t.Errorf("a path should be an invalid template name, got %v", err)
+	}
+}
+
+func TestLookup_Extends(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(generator.TemplatesEnv, dir)
+	t.Chdir(t.TempDir())
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("company-cli", "---\nextends: cli-tool\n---\n{{define \"extra\"}}## Compliance\n{{end}}")
+	write("standalone", "# {{.Title}}")
+
+	projectType, err := Lookup("company-cli")
+	if err != nil {
+		t.Fatalf("a template extending cli-tool should resolve: %v", err)
+	}
+	if _, ok := projectType.NewModel().(*models.CLITool); !ok || projectType.Name != "company-cli" {
+		t.Errorf("expected company-cli with the cli-tool model, got %s with %T", projectType.Name, projectType.NewModel())
+	}
+
+	// a template of its own has no model to render
+	if _, err := Lookup("standalone"); err == nil {
+		t.Error("a template extending nothing registered should return error")
+	}
+}
+
+func TestCheck_WrongKind(t *testing.T) {
+	projectType, err := Lookup("cli-tool")
+	if err != nil {
+		t.Fatalf("cli-tool should be registered: %v", err)
+	}
+
+	err = projectType.Check(&models.BaseInfo{})
+	if err == nil {
+		t.Fatal("cli-tool should reject *models.BaseInfo")
+	}
+	if !strings.Contains(err.Error(), "expects *models.CLITool") {
+		t.Errorf("error should name the expected model, got: %v", err)
+	}
+
+	if err := projectType.Check((*models.CLITool)(nil)); err == nil {
+		t.Error("cli-tool should reject a nil *models.CLITool")
+	}
+}
+
+func TestRender(t *testing.T) {
+	projectType, err := Lookup("basic")
+	if err != nil {
+		t.Fatalf("basic should be registered: %v", err)
+	}
+
+	baseInfo := &models.BaseInfo{
+		Title:       "Test Project",
+		Description: "This is a test project for README generation",
+		License:     "MIT",
+		Author: models.AuthorInfo{
+			Name:   "Test Author",
+			Email:  "test@example.com",
+			GitHub: "https://github.com/testuser",
+		},
+	}
+
+	filePath := filepath.Join(t.TempDir(), "README.md")
+	if err := projectType.Render(baseInfo, filePath); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		t.Errorf("Render should create %s: %v", filePath, err)
+	}
+
+	// invalid data must not produce a file
+	invalidPath := filepath.Join(t.TempDir(), "INVALID.md")
+	baseInfo.License = "INVALID"
+	if err := projectType.Render(baseInfo, invalidPath); err == nil {
+		t.Error("Render should fail validation for an invalid license")
+	}
+	if _, err := os.Stat(invalidPath); !os.IsNotExist(err) {
+		t.Error("Render should not create a file when validation fails")
+	}
+}
@@ -0,0 +1,239 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// yaml
+
+func parseYAML(path string, content []byte) (*document, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	doc := &document{value: map[string]interface{}{}, lines: map[string]int{"": 1}}
+	if len(root.Content) == 0 {
+		return doc, nil // empty file
+	}
+
+	value, err := fromYAMLNode(root.Content[0], "", doc.lines)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	mapping, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, Error{File: path, Line: root.Content[0].Line, Message: "spec must be a mapping of keys to values"}
+	}
+	doc.value = mapping
+
+	return doc, nil
+}
+
+// fromYAMLNode converts a YAML node to a generic value, recording key lines
+func fromYAMLNode(node *yaml.Node, path string, lines map[string]int) (interface{}, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		mapping := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, valueNode := node.Content[i], node.Content[i+1]
+			keyPath := joinPath(path, key.Value)
+			lines[keyPath] = key.Line
+
+			value, err := fromYAMLNode(valueNode, keyPath, lines)
+			if err != nil {
+				return nil, err
+			}
+			mapping[key.Value] = value
+		}
+		return mapping, nil
+	case yaml.SequenceNode:
+		list := make([]interface{}, len(node.Content))
+		for i, item := range node.Content {
+			itemPath := indexPath(path, i)
+			lines[itemPath] = item.Line
+
+			value, err := fromYAMLNode(item, itemPath, lines)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = value
+		}
+		return list, nil
+	case yaml.AliasNode:
+		return fromYAMLNode(node.Alias, path, lines)
+	default:
+		var value interface{}
+		if err := node.Decode(&value); err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		return value, nil
+	}
+}
+
+// json
+
+func parseJSON(path string, content []byte) (*document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var value map[string]interface{}
+	if err := decoder.Decode(&value); err != nil {
+		var syntaxError *json.SyntaxError
+		if errors.As(err, &syntaxError) {
+			return nil, Error{File: path, Line: lineAt(content, int(syntaxError.Offset)), Message: syntaxError.Error()}
+		}
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			return nil, Error{File: path, Line: lineAt(content, int(typeError.Offset)), Message: "spec must be an object of keys to values"}
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if value == nil {
+		value = map[string]interface{}{}
+	}
+
+	lines := map[string]int{"": 1}
+	scanner := json.NewDecoder(bytes.NewReader(content))
+	if err := jsonLines(scanner, content, "", lines); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &document{value: value, lines: lines}, nil
+}
+
+// jsonLines walks the JSON tokens of one value, recording key lines
+func jsonLines(decoder *json.Decoder, content []byte, path string, lines map[string]int) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			keyPath := joinPath(path, fmt.Sprint(key))
+			lines[keyPath] = lineAt(content, int(decoder.InputOffset())-1)
+
+			if err := jsonLines(decoder, content, keyPath, lines); err != nil {
+				return err
+			}
+		}
+		_, err = decoder.Token() // closing brace
+		return err
+	case json.Delim('['):
+		for i := 0; decoder.More(); i++ {
+			itemPath := indexPath(path, i)
+			lines[itemPath] = lineAt(content, nextValueOffset(content, int(decoder.InputOffset())))
+
+			if err := jsonLines(decoder, content, itemPath, lines); err != nil {
+				return err
+			}
+		}
+		_, err = decoder.Token() // closing bracket
+		return err
+	default:
+		return nil
+	}
+}
+
+// nextValueOffset skips separators to find where the next JSON value starts
+func nextValueOffset(content []byte, offset int) int {
+	for offset < len(content) && strings.ContainsRune(" \t\r\n,:", rune(content[offset])) {
+		offset++
+	}
+	return offset
+}
+
+// lineAt returns the 1-based line of a byte offset
+func lineAt(content []byte, offset int) int {
+	if offset > len(content) {
+		offset = len(content)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return bytes.Count(content[:offset], []byte("\n")) + 1
+}
+
+// toml
+
+func parseTOML(path string, content []byte) (*document, error) {
+	value := map[string]interface{}{}
+	if _, err := toml.Decode(string(content), &value); err != nil {
+		var parseError toml.ParseError
+		if errors.As(err, &parseError) {
+			return nil, Error{File: path, Line: parseError.Position.Line, Message: parseError.Message}
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &document{value: value, lines: tomlLines(content)}, nil
+}
+
+var (
+	tomlArrayTable = regexp.MustCompile(`^\[\[\s*([^\]]+?)\s*\]\]`)
+	tomlTable      = regexp.MustCompile(`^\[\s*([^\]]+?)\s*\]`)
+	tomlKey        = regexp.MustCompile(`^("[^"]*"|'[^']*'|[A-Za-z0-9_.-]+)\s*=`)
+)
+
+// tomlLines records the line of each table and key by following table headers,
+// counting [[array]] tables so repeated entries get their own index
+func tomlLines(content []byte) map[string]int {
+	lines := map[string]int{"": 1}
+	counts := map[string]int{} // array table name -> entries seen so far
+	current := ""
+
+	// tablePath turns a dotted table name into a key path with array indexes
+	tablePath := func(name string) string {
+		var path, prefix string
+		for _, segment := range strings.Split(name, ".") {
+			segment = strings.Trim(strings.TrimSpace(segment), `"'`)
+			prefix = joinPath(prefix, segment)
+			path = joinPath(path, segment)
+			if count, ok := counts[prefix]; ok {
+				path = indexPath(path, count-1)
+			}
+		}
+		return path
+	}
+
+	for i, raw := range strings.Split(string(content), "\n") {
+		line := strings.TrimSpace(raw)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case tomlArrayTable.MatchString(line):
+			name := strings.Trim(tomlArrayTable.FindStringSubmatch(line)[1], `"'`)
+			counts[name]++
+			for other := range counts {
+				if strings.HasPrefix(other, name+".") {
+					delete(counts, other) // nested arrays restart for each new entry
+				}
+			}
+			current = tablePath(name)
+			lines[current] = i + 1
+		case tomlTable.MatchString(line):
+			current = tablePath(tomlTable.FindStringSubmatch(line)[1])
+			lines[current] = i + 1
+		case tomlKey.MatchString(line):
+			key := strings.Trim(tomlKey.FindStringSubmatch(line)[1], `"'`)
+			lines[joinPath(current, key)] = i + 1
+		}
+	}
+
+	return lines
+}
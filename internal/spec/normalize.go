@@ -0,0 +1,200 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// problem is a key or value in the spec that does not fit the model
+type problem struct {
+	path    string
+	message string
+}
+
+// normalize checks a generic spec value against the model type, reporting
+// unknown keys and wrong value types, and returns a value that decodes
+// cleanly into the model. Scalars given for text fields become strings.
+func normalize(value interface{}, t reflect.Type, path string, problems *[]problem) interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if value == nil {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		mapping, ok := value.(map[string]interface{})
+		if !ok {
+			*problems = append(*problems, problem{path, "must be a mapping of keys to values"})
+			return nil
+		}
+
+		fields := fieldsByKey(t)
+		out := make(map[string]interface{}, len(mapping))
+		for key, item := range mapping {
+			keyPath := joinPath(path, key)
+			field, known := fields[key]
+			if !known {
+				*problems = append(*problems, problem{keyPath, "is not a known key"})
+				continue
+			}
+			out[key] = normalize(item, field.Type, keyPath, problems)
+		}
+		return out
+	case reflect.Slice:
+		list := reflect.ValueOf(value)
+		if list.Kind() != reflect.Slice {
+			*problems = append(*problems, problem{path, "must be a list"})
+			return nil
+		}
+
+		out := make([]interface{}, list.Len())
+		for i := range out {
+			out[i] = normalize(list.Index(i).Interface(), t.Elem(), indexPath(path, i), problems)
+		}
+		return out
+	case reflect.String:
+		switch v := value.(type) {
+		case string:
+			return v
+		case map[string]interface{}, []interface{}:
+			*problems = append(*problems, problem{path, "must be text"})
+			return nil
+		default:
+			return fmt.Sprint(v)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := toInt(value)
+		if !ok {
+			*problems = append(*problems, problem{path, "must be a whole number"})
+			return nil
+		}
+		return n
+	case reflect.Float32, reflect.Float64:
+		f, ok := toFloat(value)
+		if !ok {
+			*problems = append(*problems, problem{path, "must be a number"})
+			return nil
+		}
+		return f
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			*problems = append(*problems, problem{path, "must be true or false"})
+			return nil
+		}
+		return b
+	default:
+		return value
+	}
+}
+
+// fieldsByKey maps spec keys to struct fields, flattening embedded structs
+func fieldsByKey(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for key, embedded := range fieldsByKey(field.Type) {
+				fields[key] = embedded
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		fields[keyName(field)] = field
+	}
+	return fields
+}
+
+// keyName returns the spec key of a struct field from its json tag
+func keyName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// namespacePath converts a validator struct namespace such as
+// CLITool.BaseInfo.Commands[0].Name into a spec key path (commands[0].name)
+func namespacePath(t reflect.Type, namespace string) string {
+	segments := strings.Split(namespace, ".")
+	if len(segments) > 0 {
+		segments = segments[1:] // root type name
+	}
+
+	var path string
+	for _, segment := range segments {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			break
+		}
+
+		name, index, _ := strings.Cut(segment, "[")
+		field, ok := t.FieldByName(name)
+		if !ok {
+			break
+		}
+		t = field.Type
+		if field.Anonymous {
+			continue // embedded structs have no key of their own
+		}
+
+		path = joinPath(path, keyName(field))
+		if index != "" {
+			path += "[" + index
+		}
+	}
+
+	return path
+}
+
+// toInt accepts whole numbers from any of the spec decoders
+func toInt(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint64:
+		return int64(v), v <= math.MaxInt64
+	case float64:
+		return int64(v), v == math.Trunc(v)
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// toFloat accepts numbers from any of the spec decoders
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
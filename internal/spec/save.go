@@ -0,0 +1,50 @@
+package spec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultAnswersPath is where generate keeps the answers from the last run
+const DefaultAnswersPath = ".readme-gen/answers.yaml"
+
+// Save writes a model to a YAML spec file that names its template, so the
+// file works both as saved answers and with generate --spec
+func Save(path, templateName string, model interface{}) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+	default:
+		return fmt.Errorf("answers can only be saved as YAML (.yaml or .yml), got %s", path)
+	}
+
+	var node yaml.Node
+	if err := node.Encode(model); err != nil {
+		return fmt.Errorf("failed to encode answers: %w", err)
+	}
+
+	// the template key goes first so the file reads top to bottom
+	node.Content = append([]*yaml.Node{
+		{Kind: yaml.ScalarNode, Value: TemplateKey},
+		{Kind: yaml.ScalarNode, Value: templateName},
+	}, node.Content...)
+
+	data, err := yaml.Marshal(&node)
+	if err != nil {
+		return fmt.Errorf("failed to encode answers: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package spec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/bycait27/readme-generator/internal/registry"
+	"github.com/bycait27/readme-generator/internal/validation"
+)
+
+// TemplateKey is the top-level spec key that names the template to render
+const TemplateKey = "template"
+
+// DefaultTemplate is used when neither the spec nor the caller names a template
+const DefaultTemplate = "basic"
+
+// Error points at the place in a spec file that caused a problem
+type Error struct {
+	File    string
+	Line    int    // 0 when the line is unknown
+	Path    string // spec key path, e.g. commands[0].name
+	Message string
+}
+
+func (e Error) Error() string {
+	location := e.File
+	if e.Line > 0 {
+		location = fmt.Sprintf("%s:%d", e.File, e.Line)
+	}
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %s", location, e.Message)
+	}
+	return fmt.Sprintf("%s: %s %s", location, e.Path, e.Message)
+}
+
+// Errors collects every problem found in a spec file
+type Errors []Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, specError := range e {
+		messages[i] = specError.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// document is a parsed spec file: its generic value and the line of each key path
+type document struct {
+	value map[string]interface{}
+	lines map[string]int
+}
+
+// line returns the line of path, falling back to its closest parent
+func (d *document) line(path string) int {
+	for {
+		if line, ok := d.lines[path]; ok {
+			return line
+		}
+		if path == "" {
+			return 0
+		}
+		path = parentPath(path)
+	}
+}
+
+// Load reads a YAML, JSON or TOML spec file and decodes it into the model of
+// the template it names. A non-empty templateName overrides the spec's own
+// template key.
+func Load(path, templateName string) (registry.ProjectType, interface{}, error) {
+	return load(path, templateName, true)
+}
+
+// LoadAnswers reads saved answers to use as prompt defaults. Unlike Load it
+// skips validation and drops keys that do not fit the model, so answers saved
+// for one template can still pre-fill the shared fields of another.
+func LoadAnswers(path, templateName string) (registry.ProjectType, interface{}, error) {
+	return load(path, templateName, false)
+}
+
+func load(path, templateName string, strict bool) (registry.ProjectType, interface{}, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return registry.ProjectType{}, nil, fmt.Errorf("failed to read spec %s: %w", path, err)
+	}
+
+	doc, err := parse(path, content)
+	if err != nil {
+		return registry.ProjectType{}, nil, err
+	}
+
+	// work out which template the spec is for
+	if specTemplate, ok := doc.value[TemplateKey]; ok {
+		name, isString := specTemplate.(string)
+		if !isString {
+			return registry.ProjectType{}, nil, Error{File: path, Line: doc.line(TemplateKey), Path: TemplateKey, Message: "must be a string"}
+		}
+		if templateName == "" {
+			templateName = name
+		}
+		delete(doc.value, TemplateKey)
+	}
+	if templateName == "" {
+		templateName = DefaultTemplate
+	}
+
+	projectType, err := registry.Lookup(templateName)
+	if err != nil {
+		return registry.ProjectType{}, nil, Error{File: path, Line: doc.line(TemplateKey), Path: TemplateKey, Message: err.Error()}
+	}
+
+	// check keys and value types against the model before decoding
+	model := projectType.NewModel()
+	modelType := reflect.TypeOf(model).Elem()
+
+	var problems []problem
+	value := normalize(doc.value, modelType, "", &problems)
+	if len(problems) > 0 && strict {
+		specErrors := make(Errors, len(problems))
+		for i, p := range problems {
+			specErrors[i] = Error{File: path, Line: doc.line(p.path), Path: p.path, Message: p.message}
+		}
+		return registry.ProjectType{}, nil, specErrors
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return registry.ProjectType{}, nil, fmt.Errorf("failed to decode spec %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, model); err != nil {
+		return registry.ProjectType{}, nil, fmt.Errorf("failed to decode spec %s: %w", path, err)
+	}
+
+	if !strict {
+		return projectType, model, nil
+	}
+
+	// validate the decoded model, pointing each error at its line
+	if err := validation.ValidateStruct(model); err != nil {
+		var fieldErrors validation.Errors
+		if !errors.As(err, &fieldErrors) {
+			return registry.ProjectType{}, nil, err
+		}
+
+		specErrors := make(Errors, len(fieldErrors))
+		for i, fieldError := range fieldErrors {
+			keyPath := namespacePath(modelType, fieldError.Namespace)
+			specErrors[i] = Error{File: path, Line: doc.line(keyPath), Path: keyPath, Message: fieldError.Reason}
+		}
+		return registry.ProjectType{}, nil, specErrors
+	}
+
+	return projectType, model, nil
+}
+
+// parse decodes a spec file based on its extension
+func parse(path string, content []byte) (*document, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return parseYAML(path, content)
+	case ".json":
+		return parseJSON(path, content)
+	case ".toml":
+		return parseTOML(path, content)
+	default:
+		return nil, fmt.Errorf("unsupported spec format %s (use .yaml, .yml, .json or .toml)", filepath.Ext(path))
+	}
+}
+
+// parentPath strips the last key or index from a key path
+func parentPath(path string) string {
+	i := strings.LastIndexAny(path, ".[")
+	if i < 0 {
+		return ""
+	}
+	return path[:i]
+}
+
+// joinPath appends a key to a key path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// indexPath appends a list index to a key path
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
@@ -0,0 +1,228 @@
+package spec
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bycait27/readme-generator/internal/models"
+)
+
+func writeSpec(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write spec: %v", err)
+	}
+	return path
+}
+
+const cliToolYAML = `template: cli-tool
+title: mytool
+description: A command line tool that does useful things
+license: MIT
+author:
+  name: Test Author
+  email: test@example.com
+  github: https://github.com/testuser
+quick_start:
+  description: Get started in seconds
+  commands:
+    - mytool init
+installation:
+  package_managers:
+    - name: go
+      command: go install github.com/testuser/mytool@latest
+usage:
+  basic_usage: mytool [command] [flags]
+  description: Run any command with flags
+commands:
+  - name: init
+    description: Initialize a project
+    flags:
+      - name: port
+        short: p
+        description: Port to listen on
+        default: 8080
+examples:
+  - title: Initialize
+    description: Initialize a project here
+    commands:
+      - mytool init
+`
+
+func TestLoad_YAML(t *testing.T) {
+	path := writeSpec(t, "readme.yaml", cliToolYAML)
+
+	projectType, info, err := Load(path, "")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if projectType.Name != "cli-tool" {
+		t.Errorf("expected cli-tool template from spec, got %s", projectType.Name)
+	}
+
+	cliTool, ok := info.(*models.CLITool)
+	if !ok {
+		t.Fatalf("expected *models.CLITool, got %T", info)
+	}
+	if cliTool.Title != "mytool" || cliTool.Author.Name != "Test Author" {
+		t.Error("embedded BaseInfo fields were not decoded")
+	}
+	if got := cliTool.Commands[0].Flags[0].Default; got != "8080" {
+		t.Errorf("numeric default should decode as text, got %q", got)
+	}
+}
+
+func TestLoad_ValidationErrorLines(t *testing.T) {
+	content := strings.Replace(cliToolYAML, "description: Initialize a project", "description: Init", 1)
+	path := writeSpec(t, "readme.yml", content)
+
+	_, _, err := Load(path, "")
+	var specErrors Errors
+	if !errors.As(err, &specErrors) {
+		t.Fatalf("expected spec errors, got: %v", err)
+	}
+	if len(specErrors) != 1 {
+		t.Fatalf("expected one error, got: %v", err)
+	}
+
+	got := specErrors[0]
+	if got.Path != "commands[0].description" || got.Line != 22 {
+		t.Errorf("expected commands[0].description on line 22, got %s on line %d", got.Path, got.Line)
+	}
+	if !strings.Contains(got.Error(), "readme.yml:22: commands[0].description must be at least 5 characters") {
+		t.Errorf("unexpected message: %v", got)
+	}
+}
+
+func TestLoad_JSONUnknownKey(t *testing.T) {
+	path := writeSpec(t, "readme.json", `{
+  "title": "Test Project",
+  "description": "This is a test project for README generation",
+  "license": "MIT",
+  "author": {
+    "name": "Test Author",
+    "email": "test@example.com",
+    "githb": "https://github.com/testuser"
+  }
+}`)
+
+	_, _, err := Load(path, "")
+	var specErrors Errors
+	if !errors.As(err, &specErrors) {
+		t.Fatalf("expected spec errors, got: %v", err)
+	}
+	if specErrors[0].Path != "author.githb" || specErrors[0].Line != 8 {
+		t.Errorf("expected unknown author.githb on line 8, got %s on line %d", specErrors[0].Path, specErrors[0].Line)
+	}
+}
+
+func TestLoad_TOML(t *testing.T) {
+	path := writeSpec(t, "readme.toml", `template = "api-service"
+title = "Orders API"
+description = "A service that manages customer orders"
+license = "MIT"
+
+[author]
+name = "Test Author"
+email = "test@example.com"
+github = "https://github.com/testuser"
+
+[getting_started]
+run_commands = ["go run ."]
+
+[api_docs]
+base_url = "https://api.example.com"
+authentication = "Bearer token"
+
+[[api_docs.endpoints]]
+method = "GET"
+path = "/orders"
+description = "List all orders"
+response = "[]"
+
+[[api_docs.endpoints]]
+method = "FETCH"
+path = "/orders/{id}"
+description = "Get one order"
+response = "{}"
+`)
+
+	_, _, err := Load(path, "")
+	var specErrors Errors
+	if !errors.As(err, &specErrors) {
+		t.Fatalf("expected spec errors, got: %v", err)
+	}
+	if specErrors[0].Path != "api_docs.endpoints[1].method" || specErrors[0].Line != 25 {
+		t.Errorf("expected api_docs.endpoints[1].method on line 25, got %s on line %d", specErrors[0].Path, specErrors[0].Line)
+	}
+
+	// the -t flag overrides the spec's own template
+	_, _, err = Load(path, "cli-tool")
+	if err == nil || !strings.Contains(err.Error(), "api_docs is not a known key") {
+		t.Errorf("template override should check keys against CLITool, got: %v", err)
+	}
+}
+
+func TestLoad_UnsupportedFormat(t *testing.T) {
+	path := writeSpec(t, "readme.ini", "title=nope")
+	if _, _, err := Load(path, ""); err == nil {
+		t.Error("unsupported spec format should return error")
+	}
+}
+
+func TestSave_RoundTrip(t *testing.T) {
+	_, info, err := Load(writeSpec(t, "readme.yaml", cliToolYAML), "")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	answersPath := filepath.Join(t.TempDir(), ".readme-gen", "answers.yaml")
+	if err := Save(answersPath, "cli-tool", info); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	content, err := os.ReadFile(answersPath)
+	if err != nil {
+		t.Fatalf("Failed to read saved answers: %v", err)
+	}
+	if !strings.HasPrefix(string(content), "template: cli-tool\n") {
+		t.Errorf("saved answers should start with the template key, got:\n%s", content)
+	}
+
+	projectType, reloaded, err := Load(answersPath, "")
+	if err != nil {
+		t.Fatalf("saved answers should load as a spec: %v", err)
+	}
+	if projectType.Name != "cli-tool" {
+		t.Errorf("expected cli-tool template, got %s", projectType.Name)
+	}
+	if !reflect.DeepEqual(info, reloaded) {
+		t.Errorf("answers changed in the round trip:\nsaved:    %+v\nreloaded: %+v", info, reloaded)
+	}
+}
+
+func TestLoadAnswers_OtherTemplate(t *testing.T) {
+	path := writeSpec(t, "answers.yaml", cliToolYAML)
+
+	// cli-tool answers still pre-fill the shared fields of an api-service
+	projectType, info, err := LoadAnswers(path, "api-service")
+	if err != nil {
+		t.Fatalf("LoadAnswers failed: %v", err)
+	}
+	if projectType.Name != "api-service" {
+		t.Errorf("expected api-service template, got %s", projectType.Name)
+	}
+
+	apiService, ok := info.(*models.APIService)
+	if !ok {
+		t.Fatalf("expected *models.APIService, got %T", info)
+	}
+	if apiService.Title != "mytool" || apiService.Author.Email != "test@example.com" {
+		t.Error("shared BaseInfo fields should carry over between templates")
+	}
+}
@@ -3,6 +3,8 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -12,6 +14,74 @@ var validate *validator.Validate
 
 func init() {
 	validate = validator.New()
+	validate.RegisterValidation("regexp", isRegexp)
+	validate.RegisterValidation("gtefieldifset", isGteFieldIfSet)
+	validate.RegisterValidation("graphqlname", isGraphQLName)
+	validate.RegisterValidation("graphqltype", isGraphQLType)
+	validate.RegisterValidation("protoname", isProtoName)
+}
+
+var (
+	graphQLName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	graphQLType = regexp.MustCompile(`^(\[\s*)*[_A-Za-z][_0-9A-Za-z]*!?(\s*\]!?)*$`)
+	protoName   = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*(\.[_A-Za-z][_0-9A-Za-z]*)*$`)
+)
+
+// isGraphQLName checks a GraphQL name such as a field, argument or type
+func isGraphQLName(fl validator.FieldLevel) bool {
+	return graphQLName.MatchString(fl.Field().String())
+}
+
+// isGraphQLType checks a GraphQL type reference such as [Pet!]!, with
+// balanced list brackets
+func isGraphQLType(fl validator.FieldLevel) bool {
+	reference := fl.Field().String()
+	return graphQLType.MatchString(reference) && strings.Count(reference, "[") == strings.Count(reference, "]")
+}
+
+// isProtoName checks a protobuf name, which may be qualified with its
+// package such as pets.v1.Pet
+func isProtoName(fl validator.FieldLevel) bool {
+	return protoName.MatchString(fl.Field().String())
+}
+
+// isGteFieldIfSet is gtefield for optional numbers: it only compares when
+// the other field is set too
+func isGteFieldIfSet(fl validator.FieldLevel) bool {
+	other, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !ok || kind != reflect.Float32 && kind != reflect.Float64 {
+		return true // unset
+	}
+	return fl.Field().Float() >= other.Float()
+}
+
+// isRegexp checks that a field holds a regular expression that compiles
+func isRegexp(fl validator.FieldLevel) bool {
+	_, err := regexp.Compile(fl.Field().String())
+	return err == nil
+}
+
+// FieldError describes a single field that failed validation
+type FieldError struct {
+	Namespace string // struct path of the field, e.g. CLITool.Commands[0].Name
+	Field     string // field name, e.g. Name
+	Reason    string // what is wrong, e.g. "is required"
+}
+
+// Message returns the readable message for the field, e.g. "Name is required"
+func (e FieldError) Message() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Reason)
+}
+
+// Errors is returned by ValidateStruct when one or more fields are invalid
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldError := range e {
+		messages[i] = fieldError.Message()
+	}
+	return fmt.Sprintf("validation failed: %s", strings.Join(messages, ", "))
 }
 
 // ValidateStruct validates any struct with validation tags
@@ -29,41 +99,65 @@ func makeErrorReadable(err error) error {
 
 	// check if it's a validation error
 	if errors.As(err, &validationErrors) {
-		var messages []string
+		readable := make(Errors, 0, len(validationErrors))
 
 		// convert each error to a readable message
 		for _, e := range validationErrors {
-			message := makeFieldErrorReadable(e)
-			messages = append(messages, message)
+			readable = append(readable, FieldError{
+				Namespace: e.StructNamespace(),
+				Field:     e.Field(),
+				Reason:    makeFieldErrorReadable(e),
+			})
 		}
 
-		// join all messages
-		return fmt.Errorf("validation failed: %s", strings.Join(messages, ", "))
+		return readable
 	}
 
 	// if it's not a validation error, return as-is
 	return err
 }
 
-// makeFieldErrorReadable converts a single field error to readable text
+// makeFieldErrorReadable explains why a single field failed validation
 func makeFieldErrorReadable(e validator.FieldError) string {
-	field := e.Field()
+	// length rules on lists count items rather than characters
+	unit := "characters"
+	if e.Kind() == reflect.Slice || e.Kind() == reflect.Map {
+		unit = "items"
+	}
 
 	switch e.Tag() {
 	case "required":
-		return fmt.Sprintf("%s is required", field)
+		return "is required"
 	case "email":
-		return fmt.Sprintf("%s must be a valid email", field)
+		return "must be a valid email"
 	case "url":
-		return fmt.Sprintf("%s must be a valid URL", field)
+		return "must be a valid URL"
 	case "min":
-		return fmt.Sprintf("%s must be at least %s characters", field, e.Param())
+		return fmt.Sprintf("must be at least %s %s", e.Param(), unit)
 	case "max":
-		return fmt.Sprintf("%s must be at most %s characters", field, e.Param())
+		return fmt.Sprintf("must be at most %s %s", e.Param(), unit)
+	case "len":
+		return fmt.Sprintf("must be exactly %s %s", e.Param(), unit)
 	case "oneof":
 		options := strings.ReplaceAll(e.Param(), " ", ", ")
-		return fmt.Sprintf("%s must be one of: %s", field, options)
+		return fmt.Sprintf("must be one of: %s", options)
+	case "startswith":
+		return fmt.Sprintf("must start with %s", e.Param())
+	case "gtefield", "gtefieldifset":
+		return fmt.Sprintf("must be at least %s", e.Param())
+	case "json":
+		return "must be valid JSON"
+	case "regexp":
+		return "must be a valid regular expression"
+	case "graphqlname":
+		return "must be a GraphQL name (letters, digits and _)"
+	case "graphqltype":
+		return "must be a GraphQL type such as String! or [Pet!]!"
+	case "protoname":
+		return "must be a protobuf name such as pets.v1.Pet"
+	case "required_without", "required_without_all":
+		return fmt.Sprintf("is required without %s", strings.ReplaceAll(e.Param(), " ", " or "))
 	default:
-		return fmt.Sprintf("%s is invalid", field)
+		return "is invalid"
 	}
 }
@@ -0,0 +1,12 @@
+// Package templates holds the built-in README templates, which are
+// embedded in the binary so an installed readme-gen needs no files next
+// to it
+package templates
+
+import "embed"
+
+// FS holds one <name>.md file per built-in template and the partials
+// they share
+//
+//go:embed *.md partials/*.md
+var FS embed.FS